@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/runner"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var buildCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Build the production binary",
+	Long: `Build the frontend and the production binary embedding it.
+
+The binary is compiled with -trimpath, and the version, commit and date of the build are set with
+-X main.version, -X main.commit and -X main.date when the main package declares these variables.
+Cross-compile with --target, e.g. --target linux/amd64,linux/arm64`,
+	Args: cobra.NoArgs,
+	Run:  build,
+}
+
+func init() {
+	rootCmd.AddCommand(buildCmd)
+	buildCmd.Flags().StringSlice("target", nil, "Platforms to compile for, e.g. linux/amd64,linux/arm64 (default is the current platform)")
+	buildCmd.Flags().StringP("output", "o", "dist", "Folder of the binaries")
+	buildCmd.Flags().String("main", ".", "Main package of the Go server")
+	buildCmd.Flags().String("version", "", "Version of the build (default is git describe)")
+	buildCmd.Flags().String("ldflags", "", "Additional flags passed to the linker")
+	buildCmd.Flags().Bool("skip-frontend", false, "Do not build the frontend")
+	buildCmd.Flags().String("package-manager", "", "Package manager of the frontend, overriding the lock file (default is detected from the lock file, then the frontend.package_manager setting)")
+}
+
+type buildTarget struct {
+	GOOS   string
+	GOARCH string
+}
+
+func build(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	targetFlags, _ := cmd.Flags().GetStringSlice("target")
+	targets, err := parseBuildTargets(targetFlags)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	if skipFrontend, _ := cmd.Flags().GetBool("skip-frontend"); !skipFrontend {
+		if err := buildFrontend(currentWorkingDir); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+	}
+
+	moduleName, err := utils.GetModuleName(currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	version, _ := cmd.Flags().GetString("version")
+	gitVersion, commit := utils.GitVersion(currentWorkingDir)
+	if version == "" {
+		version = gitVersion
+	}
+	if version == "" {
+		version = "dev"
+	}
+
+	ldflags := []string{
+		"-s", "-w",
+		"-X", "main.version=" + version,
+		"-X", "main.commit=" + commit,
+		"-X", "main.date=" + time.Now().UTC().Format(time.RFC3339),
+	}
+	if extra, _ := cmd.Flags().GetString("ldflags"); extra != "" {
+		ldflags = append(ldflags, extra)
+	}
+
+	output, _ := cmd.Flags().GetString("output")
+	if !filepath.IsAbs(output) {
+		output = filepath.Join(currentWorkingDir, output)
+	}
+	if err := os.MkdirAll(output, 0755); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	mainPackage, _ := cmd.Flags().GetString("main")
+	name := utils.GetLastPathName(moduleName)
+
+	var artifacts []string
+	for _, target := range targets {
+		binName := name
+		if len(targetFlags) > 0 {
+			binName = fmt.Sprintf("%s_%s_%s", name, target.GOOS, target.GOARCH)
+		}
+		if target.GOOS == "windows" {
+			binName += ".exe"
+		}
+
+		fmt.Println(ui.TextGray.Render(fmt.Sprintf("compiling %s for %s/%s", version, target.GOOS, target.GOARCH)))
+
+		binPath := filepath.Join(output, binName)
+		if err := compileBinary(currentWorkingDir, mainPackage, binPath, target, strings.Join(ldflags, " ")); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		artifacts = append(artifacts, binPath)
+	}
+
+	if err := printArtifacts(currentWorkingDir, output, artifacts); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+}
+
+// parseBuildTargets parses the GOOS/GOARCH pairs of --target, the current platform by default
+func parseBuildTargets(values []string) ([]*buildTarget, error) {
+	if len(values) == 0 {
+		return []*buildTarget{{GOOS: runtime.GOOS, GOARCH: runtime.GOARCH}}, nil
+	}
+
+	var targets []*buildTarget
+	for _, value := range values {
+		goos, goarch, ok := strings.Cut(strings.TrimSpace(value), "/")
+		if !ok || goos == "" || goarch == "" {
+			return nil, fmt.Errorf("invalid target %s, use GOOS/GOARCH, e.g. linux/amd64", value)
+		}
+		targets = append(targets, &buildTarget{GOOS: goos, GOARCH: goarch})
+	}
+
+	return targets, nil
+}
+
+// buildFrontend runs the build script of package.json and checks that vite wrote the manifest
+func buildFrontend(currentWorkingDir string) error {
+	if !utils.DoesDirectoryOrFileExist(filepath.Join(currentWorkingDir, "package.json")) {
+		return nil
+	}
+
+	fe, err := utils.DetectFrontend(currentWorkingDir)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := fe.Scripts["build"]; !ok {
+		fmt.Println(ui.TextWarning.Render("package.json has no build script, the frontend is not built"))
+		fmt.Println()
+		return nil
+	}
+
+	if !utils.DoesDirectoryOrFileExist(filepath.Join(currentWorkingDir, "node_modules")) {
+		return fmt.Errorf("node_modules not found, install the dependencies with: %s install", fe.PackageManager)
+	}
+
+	fmt.Println(ui.TextGray.Render(fmt.Sprintf("building the frontend with %s run build", fe.PackageManager)))
+	fmt.Println()
+
+	npm := &runner.Command{Name: fe.PackageManager, Args: []string{"run", "build"}, Dir: currentWorkingDir, Stdout: os.Stdout, Stderr: os.Stderr}
+	if _, err := runner.Run(context.Background(), npm); err != nil {
+		// the output was streamed to the terminal
+		return fmt.Errorf("%s failed", npm.CommandLine())
+	}
+
+	manifest, err := utils.FindViteManifest(currentWorkingDir)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println(ui.TextGreen.Render("frontend built") + ui.TextGray.Render(", "+relOrAbs(currentWorkingDir, manifest)))
+	fmt.Println()
+
+	return nil
+}
+
+func compileBinary(currentWorkingDir, mainPackage, binPath string, target *buildTarget, ldflags string) error {
+	goBuild := &runner.Command{
+		Name:   "go",
+		Args:   []string{"build", "-trimpath", "-ldflags", ldflags, "-o", binPath, mainPackage},
+		Dir:    currentWorkingDir,
+		Env:    []string{"GOOS=" + target.GOOS, "GOARCH=" + target.GOARCH},
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+	}
+	if target.GOOS != runtime.GOOS || target.GOARCH != runtime.GOARCH {
+		// cgo needs a cross compiler
+		goBuild.Env = append(goBuild.Env, "CGO_ENABLED=0")
+	}
+
+	if _, err := runner.Run(context.Background(), goBuild); err != nil {
+		// the compile errors were streamed to the terminal
+		return fmt.Errorf("go build failed for %s/%s", target.GOOS, target.GOARCH)
+	}
+
+	return nil
+}
+
+// printArtifacts prints the size and the SHA-256 sum of the binaries, and writes the sums to checksums.txt
+func printArtifacts(currentWorkingDir, output string, artifacts []string) error {
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(ui.TextGray).
+		Headers("ARTIFACT", "SIZE", "SHA-256").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 1)
+			if row == 0 {
+				return style.Bold(true)
+			}
+			if col == 2 {
+				return style.Inherit(ui.TextGray)
+			}
+			return style
+		})
+
+	var checksums strings.Builder
+	for _, artifact := range artifacts {
+		info, err := os.Stat(artifact)
+		if err != nil {
+			return err
+		}
+
+		sum, err := utils.FileSHA256(artifact)
+		if err != nil {
+			return err
+		}
+
+		checksums.WriteString(sum + "  " + filepath.Base(artifact) + "\n")
+		t.Row(relOrAbs(currentWorkingDir, artifact), utils.FormatBytes(info.Size()), sum)
+	}
+
+	checksumsPath := filepath.Join(output, "checksums.txt")
+	if err := os.WriteFile(checksumsPath, []byte(checksums.String()), 0644); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println(t.Render())
+	fmt.Println(ui.TextGray.PaddingLeft(1).Render("checksums written to " + relOrAbs(currentWorkingDir, checksumsPath)))
+	fmt.Println()
+
+	return nil
+}
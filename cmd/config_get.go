@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var configGetCmd = &cobra.Command{
+	Use:   "config:get [key]",
+	Short: "Print the value of a setting",
+	Long: `Print the value of a setting, e.g. refiber-cli config:get ui.theme
+
+The value is resolved from the defaults, the configuration of the user, the refiber.yaml of the project,
+the REFIBER_* environment variables and the flags, each one overriding the previous ones.`,
+	Args: cobra.ExactArgs(1),
+	Run:  getConfigValue,
+}
+
+func init() {
+	rootCmd.AddCommand(configGetCmd)
+}
+
+func getConfigValue(cmd *cobra.Command, args []string) {
+	key, err := utils.GetConfigKey(args[0])
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	config := utils.GetConfig()
+	if key.Paths {
+		for _, p := range config.GetPaths(key.Name) {
+			fmt.Println(p)
+		}
+		return
+	}
+
+	fmt.Println(config.Get(key.Name))
+}
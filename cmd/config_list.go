@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var configListCmd = &cobra.Command{
+	Use:   "config:list",
+	Short: "List the settings and where their values come from",
+	Long: `List the settings and where their values come from.
+
+The values are resolved from the defaults, the configuration of the user, the refiber.yaml of the project,
+the REFIBER_* environment variables and the flags, each one overriding the previous ones.`,
+	Args: cobra.NoArgs,
+	Run:  listConfigValues,
+}
+
+func init() {
+	rootCmd.AddCommand(configListCmd)
+	configListCmd.Flags().Bool("json", false, "Output the settings as JSON")
+}
+
+type configListItem struct {
+	Key         string   `json:"key"`
+	Value       string   `json:"value"`
+	Layer       string   `json:"layer"`
+	Source      string   `json:"source,omitempty"`
+	Env         string   `json:"env"`
+	Flag        string   `json:"flag,omitempty"`
+	Values      []string `json:"values,omitempty"`
+	Description string   `json:"description"`
+}
+
+func listConfigValues(cmd *cobra.Command, args []string) {
+	var items []*configListItem
+	for _, v := range utils.GetConfig().Values() {
+		item := &configListItem{
+			Key:         v.Key.Name,
+			Value:       v.Value,
+			Layer:       v.Layer,
+			Source:      v.Source,
+			Env:         v.Key.EnvName(),
+			Values:      v.Key.Values,
+			Description: v.Key.Description,
+		}
+		if v.Key.Flag != "" {
+			item.Flag = "--" + v.Key.Flag
+		}
+		items = append(items, item)
+	}
+
+	if asJSON, _ := cmd.Flags().GetBool("json"); asJSON {
+		out, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		fmt.Println(string(out))
+		return
+	}
+
+	fmt.Println()
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(ui.TextGray).
+		Headers("KEY", "VALUE", "FROM", "DESCRIPTION").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 1)
+			if row == 0 {
+				return style.Bold(true)
+			}
+			if col == 2 || col == 3 {
+				return style.Inherit(ui.TextGray)
+			}
+			return style
+		})
+
+	for _, item := range items {
+		from := item.Layer
+		if item.Source != "" {
+			from += " (" + item.Source + ")"
+		}
+		value := strings.Join(filepath.SplitList(item.Value), "\n")
+		t.Row(item.Key, value, from, item.Description)
+	}
+
+	fmt.Println(t.Render())
+	fmt.Println()
+	fmt.Println(ui.TextGray.Render("change a setting with refiber-cli config:set <key> <value>"))
+	fmt.Println()
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var configSetCmd = &cobra.Command{
+	Use:   "config:set [key] [value]",
+	Short: "Change a setting in the configuration of the user or of the project",
+	Long: `Change a setting in the configuration of the user, or in the refiber.yaml of the project with --local.
+
+  refiber-cli config:set module.prefix github.com/kevin
+  refiber-cli config:set controller.receiver h --local
+  refiber-cli config:set templates.sources ~/refiber/stubs ./stubs/shared
+  refiber-cli config:set ui.theme --unset
+
+See refiber-cli config:list for the settings.`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  setConfigValue,
+}
+
+func init() {
+	rootCmd.AddCommand(configSetCmd)
+	configSetCmd.Flags().Bool("local", false, "Change the refiber.yaml of the project instead of the configuration of the user")
+	configSetCmd.Flags().Bool("unset", false, "Remove the setting, the value of the previous layer is used")
+}
+
+func setConfigValue(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	key, err := utils.GetConfigKey(args[0])
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	unset, _ := cmd.Flags().GetBool("unset")
+	values := args[1:]
+	switch {
+	case unset && len(values) > 0:
+		cobra.CheckErr(ui.TextError.Render("--unset doesn't take a value"))
+	case !unset && len(values) == 0:
+		cobra.CheckErr(ui.TextError.Render("missing the value of " + key.Name))
+	case !key.Paths && len(values) > 1:
+		cobra.CheckErr(ui.TextError.Render(key.Name + " takes a single value"))
+	}
+
+	layer := utils.ConfigLayerUser
+	path := configFile
+	baseDir := ""
+	if local, _ := cmd.Flags().GetBool("local"); local {
+		currentWorkingDir, err := getProjectDir()
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		layer = utils.ConfigLayerProject
+		path = filepath.Join(currentWorkingDir, utils.ProjectConfigFileName)
+		baseDir = currentWorkingDir
+	} else if path == "" {
+		if path, err = utils.GetUserConfigPath(); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+	}
+
+	if key.Paths {
+		for i, p := range values {
+			if values[i], err = configPathValue(p, baseDir); err != nil {
+				cobra.CheckErr(ui.TextError.Render(err.Error()))
+			}
+		}
+	}
+	value := strings.Join(values, string(os.PathListSeparator))
+
+	if err := utils.WriteConfigValue(path, key, value); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	if unset {
+		fmt.Println(ui.TextGreen.Render("✓") + " " + key.Name + " removed from " + ui.TextGray.Render(path))
+	} else {
+		fmt.Println(ui.TextGreen.Render("✓") + " " + key.Name + " = " + strings.Join(values, ", ") + " " + ui.TextGray.Render("in "+path))
+	}
+
+	// a layer after the changed one still decides the value, the skipped settings were already reported
+	if config, _ := resolveConfig(cmd); config != nil {
+		if current := config.Lookup(key.Name); configLayerIndex(current.Layer) > configLayerIndex(layer) {
+			fmt.Println(ui.TextWarning.Render(fmt.Sprintf("%s is overridden by %s", key.Name, current.Source)))
+		}
+	}
+	fmt.Println()
+}
+
+// configPathValue returns the folder written to a configuration file: absolute in the
+// configuration of the user, relative to the project in refiber.yaml
+func configPathValue(p, projectDir string) (string, error) {
+	if p == "~" || strings.HasPrefix(p, "~/") {
+		return p, nil
+	}
+
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return "", err
+	}
+
+	if projectDir != "" {
+		if rel, err := filepath.Rel(projectDir, abs); err == nil && !strings.HasPrefix(rel, "..") {
+			return filepath.ToSlash(rel), nil
+		}
+	}
+
+	return abs, nil
+}
+
+func configLayerIndex(layer string) int {
+	for i, l := range []string{utils.ConfigLayerDefault, utils.ConfigLayerUser, utils.ConfigLayerProject, utils.ConfigLayerEnv, utils.ConfigLayerFlag} {
+		if l == layer {
+			return i
+		}
+	}
+
+	return -1
+}
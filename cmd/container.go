@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+// generatedComponent is a generated service or repository
+type generatedComponent struct {
+	Name        string // ProductService
+	PackageName string // services
+	ImportPath  string // bykevin.work/refiber/app/services
+	Type        string // services.ProductService
+	Variable    string // productService
+	Source      []byte
+}
+
+// modelBinding is the model of the --model flag
+type modelBinding struct {
+	Name       string // Product
+	Type       string // models.Product
+	Variable   string // product
+	ImportPath string // bykevin.work/refiber/app/models
+}
+
+func getModelBinding(currentWorkingDir *string, name string) (*modelBinding, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	moduleName, err := utils.GetModuleName(*currentWorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	name = utils.ToPascalCase(utils.Singularize(name))
+	if !utils.DoesDirectoryOrFileExist(filepath.Join(*currentWorkingDir, "app", "models", name+".go")) {
+		return nil, fmt.Errorf("the model %s doesn't exist in app/models", name)
+	}
+
+	return &modelBinding{
+		Name:       name,
+		Type:       "models." + name,
+		Variable:   utils.GetLowercaseFirstChar(name),
+		ImportPath: path.Join(moduleName, "app", "models"),
+	}, nil
+}
+
+// createComponent renders a service or repository template into app/<folder>
+func createComponent(tx *generator.Transaction, currentWorkingDir *string, folder, templateName, name string, data func(c *generatedComponent) interface{}) (*generatedComponent, error) {
+	moduleName, err := utils.GetModuleName(*currentWorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	packageName := createPackageName(folder)
+	c := &generatedComponent{
+		Name:        name,
+		PackageName: packageName,
+		ImportPath:  path.Join(moduleName, "app", folder),
+		Type:        packageName + "." + name,
+		Variable:    utils.GetLowercaseFirstChar(name),
+	}
+
+	filePath := filepath.Join(*currentWorkingDir, "app", folder, name+".go")
+	if err := renderTemplateFile(tx, currentWorkingDir, templateName, data(c), filePath); err != nil {
+		return nil, err
+	}
+
+	if c.Source, err = tx.ReadFile(filePath); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func getContainerFilePath(currentWorkingDir *string) string {
+	return filepath.Join(*currentWorkingDir, "app", "container", "container.go")
+}
+
+// addToContainer registers the component in the Container struct and builds it in the New
+// function of app/container, the constructor arguments are the matching container fields
+func addToContainer(tx *generator.Transaction, currentWorkingDir *string, component *generatedComponent) error {
+	containerFilePath := getContainerFilePath(currentWorkingDir)
+	if !tx.Exists(containerFilePath) {
+		data := struct{ PackageName string }{PackageName: "container"}
+		if err := renderTemplateFile(tx, currentWorkingDir, "container/container.go.tmpl", data, containerFilePath); err != nil {
+			return err
+		}
+	}
+
+	src, err := tx.ReadFile(containerFilePath)
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, containerFilePath, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	structType, newFn := findContainer(file)
+	if structType == nil || newFn == nil {
+		return fmt.Errorf("unable to find the Container struct and its New function in %s", tx.Rel(containerFilePath))
+	}
+
+	// name of the field by type, e.g. repositories.ProductRepository -> ProductRepository
+	fields := map[string]string{}
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			if name.Name == component.Name {
+				// already registered
+				return nil
+			}
+			fields[types.ExprString(field.Type)] = name.Name
+		}
+	}
+
+	ret, ok := newFn.Body.List[len(newFn.Body.List)-1].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return fmt.Errorf("the New function of %s must end by returning the container", tx.Rel(containerFilePath))
+	}
+	ident, ok := ret.Results[0].(*ast.Ident)
+	if !ok {
+		return fmt.Errorf("the New function of %s returns %s, assign the container to a variable before returning it", tx.Rel(containerFilePath), types.ExprString(ret.Results[0]))
+	}
+	receiver := ident.Name
+
+	available := map[string]string{}
+	for _, param := range newFn.Type.Params.List {
+		for _, name := range param.Names {
+			available[types.ExprString(param.Type)] = name.Name
+		}
+	}
+	for fieldType, name := range fields {
+		available[fieldType] = receiver + "." + name
+	}
+
+	args, err := constructorArgs(component, available)
+	if err != nil {
+		return err
+	}
+
+	field := fmt.Sprintf("\t%s %s\n", component.Name, component.Type)
+	assignment := fmt.Sprintf("\n\t%s.%s = %s.New%s(%s)", receiver, component.Name, component.PackageName, component.Name, strings.Join(args, ", "))
+
+	// the assignment goes after the last statement before the return
+	fieldOffset := fset.Position(structType.Fields.Closing).Offset
+	assignmentOffset := fset.Position(newFn.Body.Lbrace).Offset + 1
+	if count := len(newFn.Body.List); count > 1 {
+		assignmentOffset = fset.Position(newFn.Body.List[count-2].End()).Offset
+	}
+
+	var out bytes.Buffer
+	out.Write(src[:fieldOffset])
+	out.WriteString(field)
+	out.Write(src[fieldOffset:assignmentOffset])
+	out.WriteString(assignment)
+	out.Write(src[assignmentOffset:])
+
+	fset = token.NewFileSet()
+	file, err = parser.ParseFile(fset, containerFilePath, out.Bytes(), parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	astutil.AddImport(fset, file, component.ImportPath)
+
+	var formatted bytes.Buffer
+	if err := format.Node(&formatted, fset, file); err != nil {
+		return err
+	}
+
+	return tx.Modify(containerFilePath, formatted.Bytes())
+}
+
+func findContainer(file *ast.File) (*ast.StructType, *ast.FuncDecl) {
+	var structType *ast.StructType
+	var newFn *ast.FuncDecl
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == "Container" {
+					structType, _ = ts.Type.(*ast.StructType)
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil && d.Name.Name == "New" && d.Body != nil && len(d.Body.List) > 0 {
+				newFn = d
+			}
+		}
+	}
+
+	return structType, newFn
+}
+
+// constructorArgs resolves the parameters of the component constructor to the available values by type
+func constructorArgs(component *generatedComponent, available map[string]string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", component.Source, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	constructorName := "New" + component.Name
+
+	var constructor *ast.FuncDecl
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == constructorName {
+			constructor = fn
+		}
+	}
+	if constructor == nil {
+		return nil, fmt.Errorf("the %s template has no %s function", component.PackageName, constructorName)
+	}
+
+	var args []string
+	for _, param := range constructor.Type.Params.List {
+		paramType := types.ExprString(param.Type)
+		if ident, ok := param.Type.(*ast.Ident); ok && ast.IsExported(ident.Name) {
+			// a type of the component package
+			paramType = component.PackageName + "." + ident.Name
+		}
+
+		arg, ok := available[paramType]
+		if !ok {
+			return nil, fmt.Errorf("unable to pass %s to %s from the container, create it first", paramType, constructorName)
+		}
+
+		count := len(param.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			args = append(args, arg)
+		}
+	}
+
+	return args, nil
+}
+
+// printContainerHint explains how to use the container created by the generation,
+// the CLI doesn't wire it into main.go nor the routes
+func printContainerHint(tx *generator.Transaction, currentWorkingDir *string) {
+	containerFilePath := getContainerFilePath(currentWorkingDir)
+	for _, f := range tx.Files() {
+		if f.Path == containerFilePath && f.Action == generator.Created {
+			fmt.Println(ui.TextGray.Render("The container of app/container isn't wired into the app, build it in main.go with container.New and pass it to the routes"))
+			fmt.Println()
+			return
+		}
+	}
+}
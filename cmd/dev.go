@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/ui/multiplexer"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var devCmd = &cobra.Command{
+	Use:   "dev",
+	Short: "Run the Go server and the frontend dev server",
+	Long: `Run the Go server with hot reload and the frontend dev server side by side.
+Their output is prefixed by the process, a crashed process is restarted.
+
+The Go server is rebuilt and restarted when a watched file changes, the last good
+build keeps running while the code does not compile. Use --air to run air instead.`,
+	Args: cobra.NoArgs,
+	Run:  runDev,
+}
+
+func init() {
+	rootCmd.AddCommand(devCmd)
+	devCmd.Flags().Bool("no-frontend", false, "Only run the Go server")
+	devCmd.Flags().Bool("plain", false, "Print plain interleaved logs, the default when the output is not a terminal")
+	devCmd.Flags().StringSlice("include", []string{"**/*.go", "go.mod", "go.sum"}, "Globs of the files triggering a rebuild")
+	devCmd.Flags().StringSlice("exclude", []string{"**/*_test.go", "tmp/**", "node_modules/**"}, "Globs of the files and folders not watched")
+	devCmd.Flags().Duration("debounce", 300*time.Millisecond, "Wait after the last change before rebuilding")
+	devCmd.Flags().String("main", ".", "Main package of the Go server")
+	devCmd.Flags().Bool("air", false, "Run air instead of the built-in hot reload")
+	devCmd.Flags().String("package-manager", "", "Package manager of the frontend, overriding the lock file (default is detected from the lock file, then the frontend.package_manager setting)")
+}
+
+// devProcess is a process of the dev command, see utils.Process and utils.Reloader
+type devProcess interface {
+	Supervise(ctx context.Context, events chan<- utils.ProcessEvent)
+	Signal(sig os.Signal) error
+}
+
+func runDev(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	plain, _ := cmd.Flags().GetBool("plain")
+	interactive := !plain && term.IsTerminal(int(os.Stdout.Fd()))
+
+	names, processes, err := getDevProcesses(cmd, currentWorkingDir, interactive)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// forwards the signal to the processes and stops them
+	var stopOnce sync.Once
+	stop := func(sig os.Signal) {
+		stopOnce.Do(func() {
+			for _, p := range processes {
+				p.Signal(sig)
+			}
+			cancel()
+		})
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+	defer signal.Stop(signals)
+	go func() {
+		for sig := range signals {
+			stop(sig)
+		}
+	}()
+
+	events := make(chan utils.ProcessEvent, 64)
+	var wg sync.WaitGroup
+	for _, p := range processes {
+		wg.Add(1)
+		go func(p devProcess) {
+			defer wg.Done()
+			p.Supervise(ctx, events)
+		}(p)
+	}
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	if !interactive {
+		for event := range events {
+			fmt.Println(formatDevEvent(names, event))
+		}
+		return
+	}
+
+	program := tea.NewProgram(multiplexer.InitialMultiplexerModel(names, func() { stop(os.Interrupt) }))
+	go func() {
+		for event := range events {
+			if event.State == "" || event.Err != nil {
+				program.Send(multiplexer.LineMsg{Process: event.Process, Line: formatDevLine(event)})
+			}
+			if event.State != "" {
+				program.Send(multiplexer.StatusMsg{Process: event.Process, Status: string(event.State), Failed: event.State == utils.ProcessCrashed || event.State == utils.ProcessBuildFailed})
+			}
+		}
+		program.Send(multiplexer.StoppedMsg{})
+	}()
+	defer utils.DeferTeaPanicHandler(program)
+
+	if _, err := program.Run(); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+}
+
+// getDevProcesses returns the Go server and, when the project has a dev script, the frontend dev server
+func getDevProcesses(cmd *cobra.Command, currentWorkingDir string, interactive bool) ([]string, []devProcess, error) {
+	var env []string
+	if interactive {
+		// the output of the processes is piped, keeps their colors
+		env = append(env, "FORCE_COLOR=1")
+	}
+
+	names := []string{"go"}
+	var processes []devProcess
+
+	if useAir, _ := cmd.Flags().GetBool("air"); useAir {
+		air, err := exec.LookPath("air")
+		if err != nil {
+			return nil, nil, fmt.Errorf("air not found, install it with: go install github.com/air-verse/air@latest")
+		}
+		processes = append(processes, &utils.Process{Name: "go", Command: air, Dir: currentWorkingDir, Env: env})
+	} else {
+		include, _ := cmd.Flags().GetStringSlice("include")
+		exclude, _ := cmd.Flags().GetStringSlice("exclude")
+		debounce, _ := cmd.Flags().GetDuration("debounce")
+		mainPackage, _ := cmd.Flags().GetString("main")
+
+		processes = append(processes, &utils.Reloader{
+			Name:    "go",
+			Dir:     currentWorkingDir,
+			Package: mainPackage,
+			BinDir:  filepath.Join(currentWorkingDir, "tmp", "refiber-dev"),
+			Env:     env,
+			Watch:   &utils.WatchOptions{Include: include, Exclude: exclude, Debounce: debounce},
+		})
+	}
+
+	if noFrontend, _ := cmd.Flags().GetBool("no-frontend"); noFrontend {
+		return names, processes, nil
+	}
+
+	fe, err := utils.DetectFrontend(currentWorkingDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := fe.Scripts["dev"]; !ok {
+		fmt.Println(ui.TextWarning.Render("package.json has no dev script, the frontend dev server is not started"))
+		fmt.Println()
+		return names, processes, nil
+	}
+
+	names = append(names, fe.PackageManager)
+	processes = append(processes, &utils.Process{
+		Name:    fe.PackageManager,
+		Command: fe.PackageManager,
+		Args:    []string{"run", "dev"},
+		Dir:     currentWorkingDir,
+		Env:     env,
+	})
+
+	return names, processes, nil
+}
+
+func formatDevEvent(names []string, event utils.ProcessEvent) string {
+	line := formatDevLine(event)
+	if line == "" {
+		line = ui.TextGray.Render(string(event.State))
+	}
+
+	return multiplexer.Prefix(names, event.Process) + line
+}
+
+func formatDevLine(event utils.ProcessEvent) string {
+	if event.Err != nil {
+		return ui.TextError.Render(event.Err.Error())
+	}
+	if event.Error {
+		return ui.TextError.Render(event.Line)
+	}
+
+	return event.Line
+}
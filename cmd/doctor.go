@@ -0,0 +1,420 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/version"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/modfile"
+
+	"github.com/refiber/refiber-cli/cmd/runner"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose the development environment and the project",
+	Long: `Check the tools needed by Refiber and the health of the project: the Go version required by go.mod,
+node and the package manager, air, git, the module cache, the framework templates, the .env file,
+the vendor folder and the write permissions. Exits with status 1 when a check fails.`,
+	Args: cobra.NoArgs,
+	Run:  runDoctor,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+	doctorCmd.Flags().Bool("json", false, "Output the checks as JSON")
+}
+
+type checkStatus string
+
+const (
+	checkPass checkStatus = "pass"
+	checkWarn checkStatus = "warn"
+	checkFail checkStatus = "fail"
+)
+
+type doctorCheck struct {
+	Name    string      `json:"name"`
+	Status  checkStatus `json:"status"`
+	Message string      `json:"message"`
+	Fix     string      `json:"fix,omitempty"`
+}
+
+// minNodeMajorVersion is the oldest node supported by Vite
+const minNodeMajorVersion = 18
+
+func runDoctor(cmd *cobra.Command, args []string) {
+	currentWorkingDir, projectErr := getProjectDir()
+
+	checks := []*doctorCheck{
+		checkGoVersion(currentWorkingDir, projectErr == nil),
+	}
+	checks = append(checks, checkNode(currentWorkingDir, projectErr == nil)...)
+	checks = append(checks,
+		checkAir(currentWorkingDir, projectErr == nil),
+		checkGit(),
+		checkModuleCache(),
+	)
+
+	if projectErr != nil {
+		checks = append(checks, &doctorCheck{
+			Name:    "Project",
+			Status:  checkWarn,
+			Message: projectErr.Error() + ", the project checks are skipped",
+			Fix:     "run doctor inside a Refiber project or pass --project",
+		})
+	} else {
+		checks = append(checks,
+			checkTemplates(currentWorkingDir),
+			checkEnvFile(currentWorkingDir),
+			checkVendor(currentWorkingDir),
+			checkWritePermissions(currentWorkingDir),
+		)
+	}
+
+	failed := false
+	for _, c := range checks {
+		if c.Status == checkFail {
+			failed = true
+		}
+	}
+
+	if asJSON, _ := cmd.Flags().GetBool("json"); asJSON {
+		out, err := json.MarshalIndent(checks, "", "  ")
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		fmt.Println(string(out))
+	} else {
+		printDoctorChecks(checks)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func printDoctorChecks(checks []*doctorCheck) {
+	fmt.Println()
+
+	nameLen := 0
+	for _, c := range checks {
+		if len(c.Name) > nameLen {
+			nameLen = len(c.Name)
+		}
+	}
+
+	counts := map[checkStatus]int{}
+	for _, c := range checks {
+		counts[c.Status]++
+
+		icon := ui.TextGreen.Render("✓")
+		switch c.Status {
+		case checkWarn:
+			icon = ui.TextWarning.Render("!")
+		case checkFail:
+			icon = ui.TextError.Render("✗")
+		}
+
+		fmt.Printf(" %s %s %s\n", icon, c.Name+strings.Repeat(" ", nameLen-len(c.Name)), ui.TextGray.Render(c.Message))
+		if c.Fix != "" && c.Status != checkPass {
+			fmt.Printf("   %s %s\n", strings.Repeat(" ", nameLen), ui.TextCyan.Render("fix: "+c.Fix))
+		}
+	}
+
+	fmt.Println()
+	fmt.Println(" " + strings.Join([]string{
+		ui.TextGreen.Render(fmt.Sprintf("%d passed", counts[checkPass])),
+		ui.TextWarning.Render(fmt.Sprintf("%d warning(s)", counts[checkWarn])),
+		ui.TextError.Render(fmt.Sprintf("%d failed", counts[checkFail])),
+	}, ", "))
+	fmt.Println()
+}
+
+// commandOutput returns the trimmed stdout of a command, with a timeout so a hanging tool fails its check
+func commandOutput(dir string, env []string, name string, args ...string) (string, error) {
+	return runner.Output(context.Background(), &runner.Command{Name: name, Args: args, Dir: dir, Env: env, Timeout: 30 * time.Second})
+}
+
+func checkGoVersion(currentWorkingDir string, inProject bool) *doctorCheck {
+	check := &doctorCheck{Name: "Go"}
+
+	// the local toolchain, go switches to the toolchain of go.mod with GOTOOLCHAIN=auto
+	goVersion, err := commandOutput(currentWorkingDir, []string{"GOTOOLCHAIN=local"}, "go", "env", "GOVERSION")
+	if err != nil {
+		check.Status, check.Message, check.Fix = checkFail, "go not found", "install Go from https://go.dev/dl"
+		return check
+	}
+
+	check.Status, check.Message = checkPass, goVersion
+	if !inProject {
+		return check
+	}
+
+	content, err := os.ReadFile(filepath.Join(currentWorkingDir, "go.mod"))
+	if err != nil {
+		return check
+	}
+	f, err := modfile.Parse("go.mod", content, nil)
+	if err != nil {
+		check.Status, check.Message, check.Fix = checkFail, err.Error(), "fix the syntax of go.mod"
+		return check
+	}
+	if f.Go == nil {
+		return check
+	}
+
+	required := "go" + f.Go.Version
+	check.Message = fmt.Sprintf("%s, go.mod requires %s", goVersion, required)
+	if version.Compare(goVersion, required) < 0 {
+		check.Status = checkFail
+		check.Fix = fmt.Sprintf("install %s or newer, or set GOTOOLCHAIN=auto to let go download it", required)
+	}
+
+	return check
+}
+
+func checkNode(currentWorkingDir string, inProject bool) []*doctorCheck {
+	hasPackageJSON := inProject && utils.DoesDirectoryOrFileExist(filepath.Join(currentWorkingDir, "package.json"))
+
+	// tools needed by the frontend fail in a project with a package.json
+	missing := checkWarn
+	if hasPackageJSON {
+		missing = checkFail
+	}
+
+	node := &doctorCheck{Name: "Node"}
+	nodeVersion, err := commandOutput(currentWorkingDir, nil, "node", "--version")
+	if err != nil {
+		node.Status, node.Message, node.Fix = missing, "node not found", "install Node.js from https://nodejs.org"
+	} else {
+		node.Status, node.Message = checkPass, nodeVersion
+
+		major, _ := strconv.Atoi(strings.SplitN(strings.TrimPrefix(nodeVersion, "v"), ".", 2)[0])
+		if major < minNodeMajorVersion {
+			node.Status = checkWarn
+			node.Message += fmt.Sprintf(", Vite requires node %d or newer", minNodeMajorVersion)
+			node.Fix = fmt.Sprintf("upgrade Node.js to %d or newer", minNodeMajorVersion)
+		}
+	}
+
+	packageManager := utils.GetConfig().Get(utils.ConfigPackageManager)
+	if hasPackageJSON {
+		if fe, err := utils.DetectFrontend(currentWorkingDir); err == nil {
+			packageManager = fe.PackageManager
+		}
+	}
+
+	pm := &doctorCheck{Name: packageManager}
+	pmVersion, err := commandOutput(currentWorkingDir, nil, packageManager, "--version")
+	switch {
+	case err != nil:
+		pm.Status, pm.Message, pm.Fix = missing, packageManager+" not found", "install "+packageManager
+	case hasPackageJSON && !utils.DoesDirectoryOrFileExist(filepath.Join(currentWorkingDir, "node_modules")):
+		pm.Status, pm.Message, pm.Fix = checkWarn, pmVersion+", node_modules not found", packageManager+" install"
+	default:
+		pm.Status, pm.Message = checkPass, pmVersion
+	}
+
+	return []*doctorCheck{node, pm}
+}
+
+func checkAir(currentWorkingDir string, inProject bool) *doctorCheck {
+	check := &doctorCheck{Name: "air"}
+
+	if path, err := exec.LookPath("air"); err == nil {
+		check.Status, check.Message = checkPass, path
+		return check
+	}
+
+	check.Status, check.Message = checkPass, "not installed, dev uses the built-in hot reload"
+	if inProject && utils.DoesDirectoryOrFileExist(filepath.Join(currentWorkingDir, ".air.toml")) {
+		check.Status = checkWarn
+		check.Message = "not installed but the project has a .air.toml, dev uses the built-in hot reload"
+		check.Fix = "go install github.com/air-verse/air@latest to use dev --air"
+	}
+
+	return check
+}
+
+func checkGit() *doctorCheck {
+	check := &doctorCheck{Name: "git"}
+
+	gitVersion, err := commandOutput("", nil, "git", "--version")
+	if err != nil {
+		check.Status, check.Message, check.Fix = checkWarn, "git not found, build can not detect the version", "install git from https://git-scm.com"
+		return check
+	}
+
+	check.Status, check.Message = checkPass, strings.TrimPrefix(gitVersion, "git version ")
+	return check
+}
+
+func checkModuleCache() *doctorCheck {
+	check := &doctorCheck{Name: "Module cache"}
+
+	dirs := utils.GetModuleCacheDirs()
+	if len(dirs) == 0 {
+		check.Status, check.Message, check.Fix = checkFail, "GOMODCACHE and GOPATH are not set", "set GOPATH or GOMODCACHE"
+		return check
+	}
+
+	dir := dirs[0]
+	if !utils.DoesDirectoryOrFileExist(dir) {
+		check.Status, check.Message = checkWarn, dir+" does not exist yet"
+		check.Fix = "go mod download creates it"
+		return check
+	}
+
+	if err := checkWritable(dir); err != nil {
+		check.Status, check.Message = checkFail, dir+" is not writable"
+		check.Fix = "fix the permissions of the folder or set GOMODCACHE to a writable folder"
+		return check
+	}
+
+	check.Status, check.Message = checkPass, dir
+	return check
+}
+
+func checkTemplates(currentWorkingDir string) *doctorCheck {
+	check := &doctorCheck{Name: "Templates"}
+
+	dir, err := utils.GetRefiberTemplateDirPath(&currentWorkingDir)
+	if err != nil {
+		check.Status, check.Message = checkFail, err.Error()
+		check.Fix = "go mod download github.com/refiber/framework, or go mod vendor when the project is vendored"
+		return check
+	}
+
+	check.Status, check.Message = checkPass, relOrAbs(currentWorkingDir, *dir)
+	return check
+}
+
+func checkEnvFile(currentWorkingDir string) *doctorCheck {
+	check := &doctorCheck{Name: ".env"}
+
+	exampleKeys, err := utils.ReadEnvKeys(filepath.Join(currentWorkingDir, ".env.example"))
+	if err != nil {
+		check.Status, check.Message = checkPass, "no .env.example to compare with"
+		return check
+	}
+
+	keys, err := utils.ReadEnvKeys(filepath.Join(currentWorkingDir, ".env"))
+	if err != nil {
+		check.Status, check.Message, check.Fix = checkFail, ".env not found", "cp .env.example .env"
+		return check
+	}
+
+	missing := subtractKeys(exampleKeys, keys)
+	undocumented := subtractKeys(keys, exampleKeys)
+
+	var problems, fixes []string
+	if len(missing) > 0 {
+		problems = append(problems, "missing "+strings.Join(missing, ", "))
+		fixes = append(fixes, "add the missing keys to .env")
+	}
+	if len(undocumented) > 0 {
+		problems = append(problems, strings.Join(undocumented, ", ")+" not in .env.example")
+		fixes = append(fixes, "document the new keys in .env.example")
+	}
+
+	if len(problems) == 0 {
+		check.Status, check.Message = checkPass, "in sync with .env.example"
+		return check
+	}
+
+	check.Status, check.Message, check.Fix = checkWarn, strings.Join(problems, ", "), strings.Join(fixes, ", ")
+	return check
+}
+
+// subtractKeys returns the keys of a that are not in b
+func subtractKeys(a, b []string) []string {
+	seen := map[string]bool{}
+	for _, key := range b {
+		seen[key] = true
+	}
+
+	var keys []string
+	for _, key := range a {
+		if !seen[key] {
+			keys = append(keys, key)
+			seen[key] = true
+		}
+	}
+
+	return keys
+}
+
+func checkVendor(currentWorkingDir string) *doctorCheck {
+	check := &doctorCheck{Name: "vendor"}
+
+	if !utils.DoesDirectoryOrFileExist(filepath.Join(currentWorkingDir, "vendor")) {
+		check.Status, check.Message = checkPass, "not vendored"
+		return check
+	}
+
+	if _, err := commandOutput(currentWorkingDir, nil, "go", "list", "-mod=vendor", "-m", "all"); err != nil {
+		message := err.Error()
+
+		// the first line only names the project, the reason follows
+		var runErr *runner.Error
+		if errors.As(err, &runErr) && runErr.Tail != "" {
+			lines := strings.SplitN(runErr.Tail, "\n", 3)
+			message = lines[0]
+			if len(lines) > 1 {
+				message = strings.TrimSuffix(lines[0], ":") + ", " + strings.TrimSpace(lines[1])
+			}
+		}
+		check.Status, check.Message, check.Fix = checkFail, message, "go mod vendor"
+		return check
+	}
+
+	check.Status, check.Message = checkPass, "consistent with go.mod"
+	return check
+}
+
+func checkWritePermissions(currentWorkingDir string) *doctorCheck {
+	check := &doctorCheck{Name: "Permissions"}
+
+	// the folders written by the generators
+	var readOnly []string
+	for _, dir := range []string{".", "app", "resources", "routes", "database", "stubs"} {
+		p := filepath.Join(currentWorkingDir, dir)
+		if !utils.DoesDirectoryOrFileExist(p) {
+			continue
+		}
+		if err := checkWritable(p); err != nil {
+			readOnly = append(readOnly, dir)
+		}
+	}
+
+	if len(readOnly) > 0 {
+		check.Status, check.Message = checkFail, strings.Join(readOnly, ", ")+" not writable"
+		check.Fix = "fix the owner or the permissions of the folders, e.g. chown -R $USER " + strings.Join(readOnly, " ")
+		return check
+	}
+
+	check.Status, check.Message = checkPass, "the project folders are writable"
+	return check
+}
+
+func checkWritable(dir string) error {
+	file, err := os.CreateTemp(dir, ".refiber-doctor-")
+	if err != nil {
+		return err
+	}
+	file.Close()
+
+	return os.Remove(file.Name())
+}
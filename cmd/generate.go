@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/ui/selectInput"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+func addGeneratorFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("dry-run", false, "Show the diff of the files that would be written without writing them")
+	cmd.Flags().Bool("force", false, "Overwrite the files that already exist")
+	cmd.Flags().BoolP("interactive", "i", false, "Ask before overwriting each file that already exists")
+}
+
+// newTransaction creates the transaction every make command writes its files through
+func newTransaction(cmd *cobra.Command, root string) *generator.Transaction {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	force, _ := cmd.Flags().GetBool("force")
+	interactive, _ := cmd.Flags().GetBool("interactive")
+
+	opts := &generator.Options{DryRun: dryRun, Force: force}
+	if interactive && !force {
+		opts.Confirm = confirmOverwrite
+	}
+
+	return generator.NewTransaction(root, opts)
+}
+
+func confirmOverwrite(f *generator.File, rel string) (bool, error) {
+	overwrite, skip, showDiff := "Overwrite", "Skip", "Show the diff"
+
+	for {
+		var choice string
+		p := tea.NewProgram(selectInput.InitialSelectInputModel(&choice, rel+" already exists", []*string{&overwrite, &skip, &showDiff}))
+		if _, err := p.Run(); err != nil {
+			return false, err
+		}
+
+		if choice != showDiff {
+			return choice == overwrite, nil
+		}
+
+		fmt.Print(ui.RenderDiff(utils.UnifiedDiff("a/"+rel, "b/"+rel, f.Original(), f.Content)))
+		fmt.Println()
+	}
+}
+
+// printGeneratedFiles prints the files of the transaction, with their diff in dry run mode
+func printGeneratedFiles(tx *generator.Transaction) {
+	if tx.DryRun() {
+		for _, f := range tx.Files() {
+			diff := tx.Diff(f)
+			if diff == "" {
+				continue
+			}
+
+			if f.Action == generator.Skipped {
+				fmt.Println(ui.TextGray.Render(fmt.Sprintf("%s exists and will be skipped, use --force to overwrite it with:", tx.Rel(f.Path))))
+			}
+			fmt.Print(diff)
+			fmt.Println()
+		}
+	}
+
+	fmt.Print(tx.Tree())
+	fmt.Println()
+	fmt.Println(tx.Summary())
+
+	for _, f := range tx.Files() {
+		if f.Action == generator.Skipped {
+			fmt.Println(ui.TextGray.Render("Existing files have been skipped, use --force or --interactive to overwrite them"))
+			break
+		}
+	}
+
+	if tx.DryRun() {
+		fmt.Println(ui.TextWarning.Render("Dry run, no files have been written"))
+	}
+	fmt.Println()
+}
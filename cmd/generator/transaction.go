@@ -0,0 +1,352 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+type Action string
+
+const (
+	Created     Action = "created"
+	Modified    Action = "modified"
+	Overwritten Action = "overwritten"
+	Skipped     Action = "skipped"
+)
+
+type File struct {
+	Path     string // absolute path
+	Action   Action
+	Content  []byte
+	original []byte
+}
+
+type Options struct {
+	DryRun bool // nothing is written to disk
+	Force  bool // overwrite the existing files
+
+	// Confirm asks whether an existing file should be overwritten,
+	// without it the existing files are skipped unless Force is set
+	Confirm func(f *File, rel string) (bool, error)
+}
+
+// Transaction records every file written by the generators so a failed
+// generation can be rolled back, in dry run mode nothing is written to disk
+type Transaction struct {
+	root        string
+	opts        *Options
+	files       []*File
+	createdDirs []string
+}
+
+func NewTransaction(root string, opts *Options) *Transaction {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	return &Transaction{root: root, opts: opts}
+}
+
+func (t *Transaction) DryRun() bool {
+	return t.opts.DryRun
+}
+
+func (t *Transaction) Force() bool {
+	return t.opts.Force
+}
+
+// Exists reports whether the file exists on disk or was created in the transaction
+func (t *Transaction) Exists(path string) bool {
+	if f := t.find(path); f != nil && f.Action != Skipped {
+		return true
+	}
+
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// ReadFile reads the content of a file, including the changes made in the transaction
+func (t *Transaction) ReadFile(path string) ([]byte, error) {
+	if f := t.find(path); f != nil && f.Action != Skipped {
+		return f.Content, nil
+	}
+
+	return os.ReadFile(path)
+}
+
+// Create writes a new file. An existing file is overwritten with the Force option
+// or when confirmed, otherwise it is skipped
+func (t *Transaction) Create(path string, content []byte) error {
+	if t.find(path) != nil {
+		return fmt.Errorf("the %s is generated twice", t.Rel(path))
+	}
+
+	original, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	f := &File{Path: path, Action: Created, Content: content}
+	if err == nil {
+		f.Action, f.original = Overwritten, original
+
+		overwrite := t.opts.Force
+		if !overwrite && t.opts.Confirm != nil && !bytes.Equal(original, content) {
+			if overwrite, err = t.opts.Confirm(f, t.Rel(path)); err != nil {
+				return err
+			}
+		}
+		if !overwrite {
+			f.Action = Skipped
+		}
+	}
+
+	if f.Action != Skipped && !t.opts.DryRun {
+		if err := t.mkdirAll(filepath.Dir(path)); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			return err
+		}
+	}
+
+	t.files = append(t.files, f)
+	return nil
+}
+
+// Modify replaces the content of an existing file, keeping the original content for a rollback
+func (t *Transaction) Modify(path string, content []byte) error {
+	if f := t.find(path); f != nil && f.Action != Skipped {
+		if !t.opts.DryRun {
+			if err := os.WriteFile(path, content, 0644); err != nil {
+				return err
+			}
+		}
+		f.Content = content
+		return nil
+	}
+
+	original, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if !t.opts.DryRun {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, content, info.Mode()); err != nil {
+			return err
+		}
+	}
+
+	t.files = append(t.files, &File{Path: path, Action: Modified, Content: content, original: original})
+	return nil
+}
+
+// Rollback removes the created files and folders and restores the modified files
+func (t *Transaction) Rollback() error {
+	if t.opts.DryRun {
+		t.files = nil
+		return nil
+	}
+
+	var errs []string
+
+	for i := len(t.files) - 1; i >= 0; i-- {
+		f := t.files[i]
+
+		var err error
+		switch f.Action {
+		case Created:
+			err = os.Remove(f.Path)
+		case Modified, Overwritten:
+			err = os.WriteFile(f.Path, f.original, 0644)
+		}
+		if err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	for i := len(t.createdDirs) - 1; i >= 0; i-- {
+		// only removes empty folders
+		os.Remove(t.createdDirs[i])
+	}
+
+	t.files = nil
+	t.createdDirs = nil
+
+	if len(errs) > 0 {
+		return fmt.Errorf("rollback failed: %s", strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// Original returns the content of the file on disk before the transaction
+func (f *File) Original() []byte {
+	return f.original
+}
+
+func (t *Transaction) Files() []*File {
+	return t.files
+}
+
+// Rel returns the path relative to the root of the transaction
+func (t *Transaction) Rel(path string) string {
+	rel, err := filepath.Rel(t.root, path)
+	if err != nil {
+		return path
+	}
+
+	return rel
+}
+
+func (t *Transaction) find(path string) *File {
+	for _, f := range t.files {
+		if f.Path == path {
+			return f
+		}
+	}
+
+	return nil
+}
+
+func (t *Transaction) mkdirAll(dir string) error {
+	var missing []string
+	for d := dir; !dirExists(d); d = filepath.Dir(d) {
+		missing = append(missing, d)
+		if d == filepath.Dir(d) {
+			break
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for i := len(missing) - 1; i >= 0; i-- {
+		t.createdDirs = append(t.createdDirs, missing[i])
+	}
+
+	return nil
+}
+
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+type treeNode struct {
+	name     string
+	file     *File
+	children map[string]*treeNode
+}
+
+// Tree renders the files of the transaction as a tree
+func (t *Transaction) Tree() string {
+	root := &treeNode{children: map[string]*treeNode{}}
+
+	for _, f := range t.files {
+		node := root
+		for _, part := range strings.Split(filepath.ToSlash(t.Rel(f.Path)), "/") {
+			child, ok := node.children[part]
+			if !ok {
+				child = &treeNode{name: part, children: map[string]*treeNode{}}
+				node.children[part] = child
+			}
+			node = child
+		}
+		node.file = f
+	}
+
+	var b strings.Builder
+	writeTree(&b, root, " ")
+	return b.String()
+}
+
+func writeTree(b *strings.Builder, node *treeNode, indent string) {
+	var names []string
+	for name := range node.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		child := node.children[name]
+
+		branch, next := "├── ", "│   "
+		if i == len(names)-1 {
+			branch, next = "└── ", "    "
+		}
+
+		label := child.name
+		if child.file != nil {
+			style := ui.TextGreen
+			switch child.file.Action {
+			case Modified, Overwritten:
+				style = ui.TextWarning
+			case Skipped:
+				style = ui.TextGray
+			}
+			label += " " + style.Render("("+string(child.file.Action)+")")
+		}
+
+		b.WriteString(ui.TextGray.Render(indent+branch) + label + "\n")
+		writeTree(b, child, indent+next)
+	}
+}
+
+// Diff returns the colored unified diff of a file against the content on disk,
+// for a skipped file it is the diff the overwrite would have made
+func (t *Transaction) Diff(f *File) string {
+	rel := filepath.ToSlash(t.Rel(f.Path))
+
+	var diff string
+	switch f.Action {
+	case Created:
+		diff = utils.UnifiedDiff("/dev/null", "b/"+rel, nil, f.Content)
+	case Modified, Overwritten, Skipped:
+		diff = utils.UnifiedDiff("a/"+rel, "b/"+rel, f.original, f.Content)
+	}
+
+	if diff == "" {
+		return ""
+	}
+
+	return ui.RenderDiff(diff)
+}
+
+// Summary counts the files of the transaction by action, e.g. 2 created, 1 skipped
+func (t *Transaction) Summary() string {
+	counts := map[Action]int{}
+	for _, f := range t.files {
+		counts[f.Action]++
+	}
+
+	styles := map[Action]lipgloss.Style{
+		Created:     ui.TextGreen,
+		Modified:    ui.TextWarning,
+		Overwritten: ui.TextWarning,
+		Skipped:     ui.TextGray,
+	}
+
+	var parts []string
+	for _, action := range []Action{Created, Modified, Overwritten, Skipped} {
+		if counts[action] > 0 {
+			parts = append(parts, styles[action].Render(fmt.Sprintf("%d %s", counts[action], action)))
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
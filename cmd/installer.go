@@ -7,11 +7,11 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
 	"sync"
-	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -39,6 +39,8 @@ var warnings []*string
 
 func init() {
 	rootCmd.AddCommand(installerCmd)
+	installerCmd.Flags().String("module-prefix", "", "Prefix of the module name, e.g. github.com/kevin (default is the module.prefix setting)")
+	installerCmd.Flags().String("package-manager", "", "Package manager of the frontend (default is the frontend.package_manager setting)")
 }
 
 func installer(cmd *cobra.Command, args []string) {
@@ -78,10 +80,16 @@ func installer(cmd *cobra.Command, args []string) {
 	}
 	projectName = strings.TrimSpace(projectName)
 
+	// the module name defaults to the project name under the module prefix of the configuration
+	defaultModuleName := utils.ScaffoldModuleName
+	if prefix := utils.GetConfig().Get(utils.ConfigModulePrefix); prefix != "" {
+		defaultModuleName = path.Join(prefix, projectName)
+	}
+
 	var moduleName string
 	p := tea.NewProgram(textInput.InitialTextInputModel(&moduleName, &textInput.Config{
 		Header:      ui.TextTitle.Render("Please provide a module name"),
-		Placeholder: "bykevin.work/refiber",
+		Placeholder: defaultModuleName,
 		Validation: func(s string) error {
 			matched, _ := regexp.Match(`^([a-zA-Z0-9_\-\.]+\/)*[a-zA-Z0-9_\-\.]+$`, []byte(s))
 			if !matched {
@@ -95,8 +103,11 @@ func installer(cmd *cobra.Command, args []string) {
 	}
 
 	if moduleName == "" {
-		fmt.Println(ui.TextWarning.Render("No module name provided. Using the default module name"))
+		fmt.Println(ui.TextWarning.Render("No module name provided. Using the default module name " + defaultModuleName))
 		fmt.Println()
+		if defaultModuleName != utils.ScaffoldModuleName {
+			moduleName = defaultModuleName
+		}
 	}
 
 	moduleName = strings.TrimSpace(moduleName)
@@ -129,9 +140,10 @@ func installer(cmd *cobra.Command, args []string) {
 
 	fmt.Println("  " + ui.TextGreen.Render("cd") + " " + ui.TextGray.Render(projectName))
 	fmt.Println()
-	fmt.Println("  " + ui.TextGreen.Render("npm") + " " + ui.TextGray.Render("i && ") + ui.TextGreen.Render("npm") + " " + ui.TextGray.Render("run build"))
+	packageManager := utils.GetConfig().Get(utils.ConfigPackageManager)
+	fmt.Println("  " + ui.TextGreen.Render(packageManager) + " " + ui.TextGray.Render("install && ") + ui.TextGreen.Render(packageManager) + " " + ui.TextGray.Render("run build"))
 	fmt.Println()
-	fmt.Println("  " + ui.TextGreen.Render("air"))
+	fmt.Println("  " + ui.TextGreen.Render("refiber-cli") + " " + ui.TextGray.Render("dev"))
 
 	if len(warnings) > 0 {
 		fmt.Println()
@@ -198,21 +210,11 @@ func createNewProject(projectName, moduleName *string, progressBar *tea.Program)
 		return fmt.Errorf("could not find the latest release tag version")
 	}
 	latestRelease := releases[0]
-	refiberDownloadArchiveURL := fmt.Sprintf(`https://github.com/refiber/refiber/archive/refs/tags/v%s.tar.gz`, *latestRelease)
 
 	progressBar.Send(progress.ProgressMsg{Value: 0.60})
 
 	// download and extract release tag to project folder
-	tempFileName := fmt.Sprintf("%v_v%s.tar.gz", time.Now().Unix(), *latestRelease)
-	tempFilePath := filepath.Join(currentWorkingDir, tempFileName)
-	if err = utils.DownloadFile(refiberDownloadArchiveURL, tempFilePath); err != nil {
-		return err
-	}
-	progressBar.Send(progress.ProgressMsg{Value: 0.80})
-	if err = extractTarGz(tempFilePath, projectPath, "refiber-"+*latestRelease); err != nil {
-		return err
-	}
-	if err = os.Remove(tempFilePath); err != nil {
+	if err = downloadScaffold(*latestRelease, projectPath); err != nil {
 		return err
 	}
 
@@ -231,6 +233,25 @@ func createNewProject(projectName, moduleName *string, progressBar *tea.Program)
 	return nil
 }
 
+// downloadScaffold downloads the project scaffold of a release, e.g. 0.1.0, and extracts it to the folder
+func downloadScaffold(release, destPath string) error {
+	release = strings.TrimPrefix(release, "v")
+	refiberDownloadArchiveURL := fmt.Sprintf(`https://github.com/refiber/refiber/archive/refs/tags/v%s.tar.gz`, release)
+
+	tempFile, err := os.CreateTemp("", fmt.Sprintf("refiber-v%s-*.tar.gz", release))
+	if err != nil {
+		return err
+	}
+	tempFile.Close()
+	defer os.Remove(tempFile.Name())
+
+	if err = utils.DownloadFile(refiberDownloadArchiveURL, tempFile.Name()); err != nil {
+		return err
+	}
+
+	return extractTarGz(tempFile.Name(), destPath, "refiber-"+release)
+}
+
 func extractTarGz(filePath, destPath, folderName string) error {
 	// Open the tar.gz file
 	file, err := os.Open(filePath)
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var makeCommandCmd = &cobra.Command{
+	Use:   "make:command [name]",
+	Short: "Generate a console command",
+	Long: `Generate a maintenance command in app/console, e.g. make:command ReindexProducts.
+Run it with refiber-cli run reindex:products, the arguments and flags after the name are passed to the command.`,
+	Run: generateCommand,
+}
+
+func init() {
+	rootCmd.AddCommand(makeCommandCmd)
+	makeCommandCmd.Flags().StringP("name", "n", "", "Name of the command, derived from the type name by default, e.g. reindex:products")
+	makeCommandCmd.Flags().StringP("description", "d", "", "Description of the command")
+	addGeneratorFlags(makeCommandCmd)
+	addTemplateFlags(makeCommandCmd)
+}
+
+var commandNameRegex = regexp.MustCompile(`^[a-z0-9]+(:[a-z0-9-]+)*$`)
+
+func generateCommand(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	input := getComponentName(args, "command", "ReindexProducts")
+	if input == "" {
+		fmt.Println(ui.TextWarning.Render("Command creation has been canceled"))
+		fmt.Println()
+		return
+	}
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	typeName := getComponentBaseName(input, "Command")
+	name, _ := cmd.Flags().GetString("name")
+	if name == "" {
+		name = utils.CommandName(typeName)
+	}
+	if !commandNameRegex.MatchString(name) {
+		cobra.CheckErr(ui.TextError.Render(fmt.Sprintf("invalid command name %s, use e.g. reindex:products", name)))
+	}
+
+	commands, err := utils.ParseConsoleCommands(currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+	for _, c := range commands {
+		if c.Name == name && c.TypeName != typeName {
+			cobra.CheckErr(ui.TextError.Render(fmt.Sprintf("the %s command already exists in %s", name, c.Location)))
+		}
+	}
+
+	description, _ := cmd.Flags().GetString("description")
+
+	tx := newTransaction(cmd, currentWorkingDir)
+	if err := createCommand(tx, &currentWorkingDir, typeName, name, description); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			fmt.Println(ui.TextError.Render(rollbackErr.Error()))
+		}
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	printGeneratedFiles(tx)
+
+	if !tx.DryRun() {
+		fmt.Println("  " + ui.TextGreen.Render("refiber-cli") + " " + ui.TextGray.Render("run "+name))
+		fmt.Println()
+	}
+}
+
+// createCommand renders the command into app/console, with the Command interface of the package
+func createCommand(tx *generator.Transaction, currentWorkingDir *string, typeName, name, description string) error {
+	consoleDirPath := utils.GetConsoleDirPath(*currentWorkingDir)
+
+	type CommandData struct {
+		PackageName string // console
+		TypeName    string // ReindexProducts
+		CommandName string // reindex:products
+		Description string // Reindex the products
+		ReciverName string // cmd
+	}
+
+	if description == "" {
+		words := utils.SplitWords(typeName)
+		for i := 1; i < len(words); i++ {
+			words[i] = strings.ToLower(words[i])
+		}
+		description = strings.Join(words, " ")
+	}
+
+	data := &CommandData{
+		PackageName: "console",
+		TypeName:    typeName,
+		CommandName: name,
+		Description: description,
+		ReciverName: "cmd",
+	}
+
+	if consoleFilePath := filepath.Join(consoleDirPath, "console.go"); !tx.Exists(consoleFilePath) {
+		if err := renderTemplateFile(tx, currentWorkingDir, "console/console.go.tmpl", data, consoleFilePath); err != nil {
+			return err
+		}
+	}
+
+	return renderTemplateFile(tx, currentWorkingDir, "console/command.go.tmpl", data, filepath.Join(consoleDirPath, typeName+".go"))
+}
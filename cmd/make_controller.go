@@ -1,18 +1,21 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
-	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
-	"text/template"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/refiber/refiber-cli/cmd/generator"
 	"github.com/refiber/refiber-cli/cmd/ui"
 	"github.com/refiber/refiber-cli/cmd/ui/selectInput"
 	"github.com/refiber/refiber-cli/cmd/ui/textInput"
@@ -28,6 +31,14 @@ var makeControllerCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(makeControllerCmd)
 	makeControllerCmd.Flags().BoolP("crud", "c", false, "Create CRUD controller")
+	makeControllerCmd.Flags().BoolP("pages", "p", false, "Create the frontend pages of the CRUD controller")
+	makeControllerCmd.Flags().BoolP("requests", "r", false, "Create the Store and Update requests of the CRUD controller")
+	makeControllerCmd.Flags().BoolP("test", "t", false, "Create the test of the controller")
+	makeControllerCmd.Flags().String("framework", "", "Frontend framework of the pages (react, vue or svelte), detected from package.json by default")
+	makeControllerCmd.Flags().String("lang", "", "Language of the pages (ts or js), detected from the project by default")
+	makeControllerCmd.Flags().String("receiver", "", "Receiver name of the controller (default is the controller.receiver setting, ctr)")
+	addGeneratorFlags(makeControllerCmd)
+	addTemplateFlags(makeControllerCmd)
 }
 
 func generateController(cmd *cobra.Command, args []string) {
@@ -58,13 +69,12 @@ func generateController(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	currentWorkingDir, err := os.Getwd()
+	currentWorkingDir, err := getProjectDir()
 	if err != nil {
 		cobra.CheckErr(ui.TextError.Render(err.Error()))
 	}
 
-	templateDirPath, err := utils.GetRefiberTemplateDirPath(&currentWorkingDir)
-	if err != nil {
+	if _, err := utils.GetRefiberTemplateDirPath(&currentWorkingDir); err != nil {
 		cobra.CheckErr(ui.TextError.Render(err.Error()))
 	}
 
@@ -106,60 +116,198 @@ func generateController(cmd *cobra.Command, args []string) {
 		cDirPath = &cdp
 	}
 
-	// verify if the controller already exists
-	if utils.DoesDirectoryOrFileExist(filepath.Join(*cDirPath, *cName+".go")) {
-		cobra.CheckErr(ui.TextError.Render("the " + *cName + ".go" + " already exist"))
+	useCrud, _ := cmd.Flags().GetBool("crud")
+	useRequests, _ := cmd.Flags().GetBool("requests")
+	usePages, _ := cmd.Flags().GetBool("pages")
+	useTest, _ := cmd.Flags().GetBool("test")
+
+	if useRequests && !useCrud {
+		fmt.Println(ui.TextWarning.Render("--requests is only available for CRUD controllers, skipping the requests"))
+		useRequests = false
+	}
+	if usePages && !useCrud {
+		fmt.Println(ui.TextWarning.Render("--pages is only available for CRUD controllers, skipping the pages"))
+		usePages = false
 	}
 
-	templateFileName := "controller.go.tmpl"
+	tx := newTransaction(cmd, currentWorkingDir)
+	handleErr := func(err error) {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			fmt.Println(ui.TextError.Render(rollbackErr.Error()))
+		}
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
 
-	useCrud, _ := cmd.Flags().GetBool("crud")
-	if useCrud {
-		templateFileName = "controller_crud.go.tmpl"
+	var requests map[string]*generatedRequest
+	if useRequests {
+		if requests, err = createControllerRequests(tx, &currentWorkingDir, *cName, nil); err != nil {
+			handleErr(err)
+		}
 	}
 
-	// get template file and content
-	cTemplatePath := filepath.Join(*templateDirPath, "controller", templateFileName)
-	cTemplateContent, err := os.ReadFile(cTemplatePath)
+	controller, err := createController(tx, &currentWorkingDir, &controllerOptions{
+		Name:        *cName,
+		DirPath:     *cDirPath,
+		PackageName: packageName,
+		Crud:        useCrud,
+		Requests:    requests,
+	})
 	if err != nil {
-		cobra.CheckErr(ui.TextError.Render(err.Error()))
+		handleErr(err)
 	}
 
-	tmpl, err := template.New(*cName).Parse(string(cTemplateContent))
-	if err != nil {
-		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	if useTest {
+		if err := createControllerTest(tx, &currentWorkingDir, controller); err != nil {
+			handleErr(err)
+		}
+	}
+
+	if usePages {
+		fe, err := getFrontend(cmd, currentWorkingDir)
+		if err != nil {
+			handleErr(err)
+		}
+
+		if err := createResourcePages(tx, &currentWorkingDir, fe, controller, nil); err != nil {
+			handleErr(err)
+		}
+	}
+
+	printGeneratedFiles(tx)
+
+	if useRequests && !tx.DryRun() {
+		printValidatorHint(currentWorkingDir)
+	}
+}
+
+type controllerOptions struct {
+	Name        string // ProductController
+	DirPath     string // absolute path of the controller folder
+	PackageName string // web
+	Crud        bool
+	Requests    map[string]*generatedRequest // controller method -> request
+}
+
+type generatedController struct {
+	Name        string // ProductController
+	MethodName  string // Product
+	PackageName string // web
+	DirPath     string // absolute path of the controller folder
+	ImportPath  string // bykevin.work/refiber/app/controllers/web
+	Source      []byte
+}
+
+// createController renders the framework controller template into the controller folder
+func createController(tx *generator.Transaction, currentWorkingDir *string, opts *controllerOptions) (*generatedController, error) {
+	cFilePath := filepath.Join(opts.DirPath, opts.Name+".go")
+
+	templateFileName := "controller.go.tmpl"
+	if opts.Crud {
+		templateFileName = "controller_crud.go.tmpl"
 	}
 
 	type ControllerData struct {
-		PackageName    string // web
-		MethodName     string // Product
-		ControllerName string // ProductController
-		ModelName      string // productController
-		ReciverName    string // c
+		PackageName    string                       // web
+		MethodName     string                       // Product
+		ControllerName string                       // ProductController
+		ModelName      string                       // productController
+		ReciverName    string                       // c
+		Requests       map[string]*generatedRequest // controller method -> request, e.g. Store
+		RequestImports []string                     // import paths of the requests
 	}
 
-	modelName := utils.GetLowercaseFirstChar(*cName)
+	modelName := utils.GetLowercaseFirstChar(opts.Name)
 
 	data := &ControllerData{
-		PackageName:    createPackageName(packageName),
-		MethodName:     strings.ReplaceAll(*cName, "Controller", ""),
-		ControllerName: *cName,
+		PackageName:    createPackageName(opts.PackageName),
+		MethodName:     strings.ReplaceAll(opts.Name, "Controller", ""),
+		ControllerName: opts.Name,
 		ModelName:      modelName,
-		ReciverName:    "ctr",
+		ReciverName:    utils.GetConfig().Get(utils.ConfigControllerReceiver),
+		Requests:       opts.Requests,
+	}
+	for _, request := range opts.Requests {
+		if !slices.Contains(data.RequestImports, request.ImportPath) {
+			data.RequestImports = append(data.RequestImports, request.ImportPath)
+		}
+	}
+	sort.Strings(data.RequestImports)
+
+	// inject data to the template, the template is looked up in the stubs and then in the framework
+	templateName := "controller/" + templateFileName
+	sources := utils.GetTemplateSources(currentWorkingDir)
+	if len(opts.Requests) > 0 {
+		sources = requestTemplateSources(sources, templateName)
 	}
 
-	// inject data to the template
-	buf, err := utils.ExecuteTemplate(tmpl, data)
+	buf, err := renderTemplateFrom(currentWorkingDir, sources, templateName, data, cFilePath)
 	if err != nil {
-		cobra.CheckErr(ui.TextError.Render(err.Error()))
+		return nil, err
 	}
 
 	// write template file
-	if err = utils.WriteFile(data.ControllerName+".go", *cDirPath, buf); err != nil {
-		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	if err = tx.Create(cFilePath, buf); err != nil {
+		return nil, err
+	}
+
+	// an existing controller is skipped, the next generators work from the file on disk
+	if buf, err = tx.ReadFile(cFilePath); err != nil {
+		return nil, err
+	}
+
+	moduleName, err := utils.GetModuleName(*currentWorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := filepath.Rel(*currentWorkingDir, opts.DirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &generatedController{
+		Name:        opts.Name,
+		MethodName:  data.MethodName,
+		PackageName: data.PackageName,
+		DirPath:     opts.DirPath,
+		ImportPath:  path.Join(moduleName, filepath.ToSlash(rel)),
+		Source:      buf,
+	}, nil
+}
+
+// requestTemplateSources keeps the sources when the controller template parses the requests,
+// older framework templates don't and the template of the CLI is used instead
+func requestTemplateSources(sources []*utils.TemplateSource, templateName string) []*utils.TemplateSource {
+	content, source, err := utils.ResolveTemplate(sources, templateName)
+	if err != nil || bytes.Contains(content, []byte(".Requests")) {
+		return sources
+	}
+
+	fmt.Println(ui.TextWarning.Render(fmt.Sprintf("%s of %s doesn't parse the requests, using the template of refiber-cli", templateName, source.Name)))
+
+	var cli []*utils.TemplateSource
+	for _, s := range sources {
+		if s.Name == utils.TemplateSourceCLI {
+			cli = append(cli, s)
+		}
+	}
+	return cli
+}
+
+// createControllerRequests creates the Store and Update requests of a CRUD controller
+func createControllerRequests(tx *generator.Transaction, currentWorkingDir *string, controllerName string, fields []*utils.Field) (map[string]*generatedRequest, error) {
+	methodName := strings.ReplaceAll(controllerName, "Controller", "")
+
+	requests := map[string]*generatedRequest{}
+	for _, action := range []string{"Store", "Update"} {
+		request, err := getOrCreateRequest(tx, currentWorkingDir, action+methodName+"Request", fields)
+		if err != nil {
+			return nil, err
+		}
+		requests[action] = request
 	}
 
-	fmt.Println(ui.TextGreen.Render(*cName + ".go successfully created!"))
+	return requests, nil
 }
 
 func getControllersDirPath(currentWorkingDir *string) *string {
@@ -197,13 +345,6 @@ func getControllerNameAndPath(input string, currentWorkingDir *string) (name, pa
 		// Extract the path from the remaining parts
 		p := filepath.Join(*getControllersDirPath(currentWorkingDir), filepath.Join(parts[:len(parts)-1]...))
 		path = &p
-
-		// Check if the path exists, if not create it
-		if _, err := os.Stat(*path); os.IsNotExist(err) {
-			if err := os.MkdirAll(*path, 0755); err != nil {
-				return nil, nil, err
-			}
-		}
 	}
 
 	return name, path, err
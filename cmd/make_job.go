@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var makeJobCmd = &cobra.Command{
+	Use:   "make:job [name]",
+	Short: "Generate a queued job",
+	Long: `Generate a job with a Handle method in app/jobs, e.g. make:job SendWelcomeEmail.
+Dispatch it with jobs.Dispatch(ctx, &jobs.SendWelcomeEmail{}) and handle the queue with refiber-cli queue:work.`,
+	Run: generateJob,
+}
+
+func init() {
+	rootCmd.AddCommand(makeJobCmd)
+	makeJobCmd.Flags().Int("tries", 0, "Attempts of the job before it fails, the --tries of queue:work by default")
+	makeJobCmd.Flags().Duration("backoff", 10*time.Second, "Delay before retrying the job, multiplied by the attempt, used with --tries")
+	addGeneratorFlags(makeJobCmd)
+	addTemplateFlags(makeJobCmd)
+}
+
+func generateJob(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	input := getComponentName(args, "job", "SendWelcomeEmail")
+	if input == "" {
+		fmt.Println(ui.TextWarning.Render("Job creation has been canceled"))
+		fmt.Println()
+		return
+	}
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	tries, _ := cmd.Flags().GetInt("tries")
+	backoff, _ := cmd.Flags().GetDuration("backoff")
+
+	tx := newTransaction(cmd, currentWorkingDir)
+	if err := createJob(tx, &currentWorkingDir, getComponentBaseName(input, "Job"), tries, backoff); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			fmt.Println(ui.TextError.Render(rollbackErr.Error()))
+		}
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	printGeneratedFiles(tx)
+
+	if !tx.DryRun() {
+		fmt.Println("  " + ui.TextGreen.Render("refiber-cli") + " " + ui.TextGray.Render("queue:work"))
+		fmt.Println()
+	}
+}
+
+// createJob renders the job into app/jobs, with the queue of the package when it doesn't exist yet
+func createJob(tx *generator.Transaction, currentWorkingDir *string, name string, tries int, backoff time.Duration) error {
+	jobsDirPath := filepath.Join(*currentWorkingDir, "app", "jobs")
+
+	type JobData struct {
+		PackageName string // jobs
+		JobName     string // SendWelcomeEmail
+		ReciverName string // job
+		MaxAttempts int    // from --tries, 0 uses the attempts of the worker
+		Backoff     string // 10 * time.Second
+	}
+
+	data := &JobData{
+		PackageName: "jobs",
+		JobName:     name,
+		ReciverName: "job",
+		MaxAttempts: tries,
+		Backoff:     durationExpr(backoff),
+	}
+
+	for _, runtime := range []string{"queue", "file_driver"} {
+		if filePath := filepath.Join(jobsDirPath, runtime+".go"); !tx.Exists(filePath) {
+			if err := renderTemplateFile(tx, currentWorkingDir, "jobs/"+runtime+".go.tmpl", data, filePath); err != nil {
+				return err
+			}
+		}
+	}
+
+	return renderTemplateFile(tx, currentWorkingDir, "jobs/job.go.tmpl", data, filepath.Join(jobsDirPath, name+".go"))
+}
+
+// durationExpr returns the Go expression of a duration, e.g. 10 * time.Second
+func durationExpr(d time.Duration) string {
+	if d == 0 {
+		return "0"
+	}
+
+	units := []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+	}
+
+	for _, u := range units {
+		if d >= u.unit && d%u.unit == 0 {
+			return fmt.Sprintf("%d * %s", d/u.unit, u.name)
+		}
+	}
+
+	return fmt.Sprintf("time.Duration(%d)", d)
+}
+
+// getJobsHarnessData returns the data of the harness running the worker
+func getJobsHarnessData(currentWorkingDir string) (interface{}, error) {
+	if !utils.DoesDirectoryOrFileExist(filepath.Join(currentWorkingDir, "app", "jobs", "queue.go")) {
+		return nil, fmt.Errorf("app/jobs/queue.go not found, create a job with make:job first")
+	}
+
+	moduleName, err := utils.GetModuleName(currentWorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		PackageName string
+		ImportPath  string
+	}{
+		PackageName: "jobs",
+		ImportPath:  moduleName + "/app/jobs",
+	}, nil
+}
@@ -0,0 +1,232 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/ui/textInput"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var makePageCmd = &cobra.Command{
+	Use:   "make:page",
+	Short: "Generate a frontend page component",
+	Long:  "Generate a frontend page component, e.g. make:page Products/Index",
+	Run:   generatePage,
+}
+
+// resourcePages are the pages generated for a CRUD controller
+var resourcePages = []string{"Index", "Create", "Show", "Edit"}
+
+func init() {
+	rootCmd.AddCommand(makePageCmd)
+	makePageCmd.Flags().String("framework", "", "Frontend framework (react, vue or svelte), detected from package.json by default")
+	makePageCmd.Flags().String("lang", "", "Language of the page (ts or js), detected from the project by default")
+	addGeneratorFlags(makePageCmd)
+	addTemplateFlags(makePageCmd)
+}
+
+func generatePage(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	var input string
+	if len(args) < 1 {
+		p := tea.NewProgram(textInput.InitialTextInputModel(&input, &textInput.Config{
+			Header:      ui.TextTitle.Render("Please provide a page name"),
+			Placeholder: "Products/Index",
+			Validation: func(s string) error {
+				matched, _ := regexp.Match("^[a-zA-Z0-9_/-]+$", []byte(s))
+				if !matched {
+					return fmt.Errorf("Invalid page name")
+				}
+				return nil
+			},
+		}))
+		if _, err := p.Run(); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+	} else {
+		input = args[0]
+	}
+
+	if input == "" {
+		fmt.Println(ui.TextWarning.Render("Page creation has been canceled"))
+		fmt.Println()
+		return
+	}
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	fe, err := getFrontend(cmd, currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	tx := newTransaction(cmd, currentWorkingDir)
+	if _, err := createPage(tx, &currentWorkingDir, fe, input, nil); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	printGeneratedFiles(tx)
+}
+
+// getFrontend detects the frontend of the project and applies the --framework and --lang flags
+func getFrontend(cmd *cobra.Command, projectPath string) (*utils.Frontend, error) {
+	fe, err := utils.DetectFrontend(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if framework, _ := cmd.Flags().GetString("framework"); framework != "" {
+		framework = strings.ToLower(framework)
+		valid := false
+		for _, f := range utils.FrontendFrameworks {
+			if f == framework {
+				valid = true
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("unsupported framework %s, use one of: %s", framework, strings.Join(utils.FrontendFrameworks, ", "))
+		}
+
+		if fe.Framework != framework {
+			fe.Framework = framework
+			fe.InertiaPackage = utils.InertiaPackage(framework)
+		}
+	}
+
+	switch lang, _ := cmd.Flags().GetString("lang"); strings.ToLower(lang) {
+	case "":
+	case "ts", "typescript":
+		fe.TypeScript = true
+	case "js", "javascript":
+		fe.TypeScript = false
+	default:
+		return nil, fmt.Errorf("unsupported language %s, use ts or js", lang)
+	}
+
+	if fe.Framework == "" {
+		return nil, fmt.Errorf("unable to detect the frontend framework. Use the --framework flag to choose one")
+	}
+
+	return fe, nil
+}
+
+// createResourcePages creates the pages of a CRUD controller, e.g. Products/Index
+func createResourcePages(tx *generator.Transaction, currentWorkingDir *string, fe *utils.Frontend, controller *generatedController, fields []*utils.Field) error {
+	resource := resourcePagesFolder(controller)
+	for _, action := range resourcePages {
+		if _, err := createPage(tx, currentWorkingDir, fe, resource+"/"+action, fields); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var renderedIndexPageRegex = regexp.MustCompile(`\.Render\([^,]+,\s*"([^"]+)/Index"`)
+
+// resourcePagesFolder returns the folder of the pages rendered by the controller, e.g. Products
+// for Render(c, "Products/Index", nil), or the plural of the resource when it renders no Index page
+func resourcePagesFolder(controller *generatedController) string {
+	resource := utils.Pluralize(controller.MethodName)
+
+	match := renderedIndexPageRegex.FindSubmatch(controller.Source)
+	if match == nil {
+		return resource
+	}
+
+	folder := string(match[1])
+	if folder != resource {
+		fmt.Println(ui.TextWarning.Render(fmt.Sprintf("%s renders %s/Index instead of %s/Index, creating the pages in %s. Publish the controller template with stub:publish to change it", controller.Name, folder, resource, folder)))
+	}
+
+	return folder
+}
+
+// createPage renders the page template of the frontend framework and returns
+// the path of the created file relative to the pages folder
+func createPage(tx *generator.Transaction, currentWorkingDir *string, fe *utils.Frontend, name string, fields []*utils.Field) (string, error) {
+	parts := strings.Split(strings.Trim(filepath.ToSlash(name), "/"), "/")
+	for i, part := range parts {
+		parts[i] = utils.ToPascalCase(strings.TrimSuffix(part, filepath.Ext(part)))
+	}
+
+	dirParts := parts[:len(parts)-1]
+	pageFileName := parts[len(parts)-1] + fe.PageFileExtension()
+	pageDirPath := filepath.Join(fe.PagesDirPath, filepath.Join(dirParts...))
+	pageRelPath := filepath.Join(filepath.Join(dirParts...), pageFileName)
+
+	type PageData struct {
+		Name           string // Products/Index
+		ComponentName  string // ProductsIndex
+		Title          string // Products
+		Resource       string // Products
+		Action         string // Index
+		RoutePath      string // /products
+		TypeScript     bool
+		InertiaPackage string         // @inertiajs/react
+		Fields         []*utils.Field // form fields
+	}
+
+	action := parts[len(parts)-1]
+	resource := action
+	if len(parts) > 1 {
+		resource = parts[len(parts)-2]
+	}
+
+	routeParts := []string{}
+	for _, part := range dirParts {
+		routeParts = append(routeParts, utils.ToKebabCase(part))
+	}
+
+	data := &PageData{
+		Name:           strings.Join(parts, "/"),
+		ComponentName:  strings.Join(parts, ""),
+		Title:          pageTitle(resource, action),
+		Resource:       resource,
+		Action:         action,
+		RoutePath:      "/" + strings.Join(routeParts, "/"),
+		TypeScript:     fe.TypeScript,
+		InertiaPackage: fe.InertiaPackage,
+		Fields:         fields,
+	}
+
+	buf, err := renderTemplate(currentWorkingDir, "pages/"+fe.Framework+".tmpl", data, filepath.Join(pageDirPath, pageFileName))
+	if err != nil {
+		return "", err
+	}
+
+	if err := tx.Create(filepath.Join(pageDirPath, pageFileName), buf); err != nil {
+		return "", err
+	}
+
+	return pageRelPath, nil
+}
+
+func pageTitle(resource, action string) string {
+	words := strings.Join(utils.SplitWords(resource), " ")
+
+	switch action {
+	case "Index":
+		return words
+	case "Create":
+		return "Create " + utils.Singularize(words)
+	case "Edit":
+		return "Edit " + utils.Singularize(words)
+	case "Show":
+		return utils.Singularize(words)
+	}
+
+	return strings.Join(utils.SplitWords(action), " ")
+}
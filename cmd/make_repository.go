@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/ui/textInput"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var makeRepositoryCmd = &cobra.Command{
+	Use:   "make:repository [name]",
+	Short: "Generate a Repository interface and implementation",
+	Long: `Generate a repository interface and its implementation in app/repositories, e.g. make:repository Product --model Product.
+The repository is registered in the container of app/container.
+The container isn't wired into the app, build it in main.go with container.New and pass it to the routes.`,
+	Run: generateRepository,
+}
+
+func init() {
+	rootCmd.AddCommand(makeRepositoryCmd)
+	makeRepositoryCmd.Flags().StringP("model", "m", "", "Bind the repository to a model of app/models, e.g. Product")
+	makeRepositoryCmd.Flags().Bool("no-container", false, "Don't register the repository in the container")
+	addGeneratorFlags(makeRepositoryCmd)
+	addTemplateFlags(makeRepositoryCmd)
+}
+
+func generateRepository(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	input := getComponentName(args, "repository", "Product")
+	if input == "" {
+		fmt.Println(ui.TextWarning.Render("Repository creation has been canceled"))
+		fmt.Println()
+		return
+	}
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	modelName, _ := cmd.Flags().GetString("model")
+	model, err := getModelBinding(&currentWorkingDir, modelName)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	noContainer, _ := cmd.Flags().GetBool("no-container")
+
+	tx := newTransaction(cmd, currentWorkingDir)
+	handleErr := func(err error) {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			fmt.Println(ui.TextError.Render(rollbackErr.Error()))
+		}
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	repository, err := createRepository(tx, &currentWorkingDir, input, model)
+	if err != nil {
+		handleErr(err)
+	}
+
+	if !noContainer {
+		if err := addToContainer(tx, &currentWorkingDir, repository); err != nil {
+			handleErr(err)
+		}
+	}
+
+	printGeneratedFiles(tx)
+	printContainerHint(tx, &currentWorkingDir)
+}
+
+// getComponentName returns the name argument or asks for it
+func getComponentName(args []string, kind, placeholder string) string {
+	if len(args) > 0 {
+		return args[0]
+	}
+
+	var input string
+	p := tea.NewProgram(textInput.InitialTextInputModel(&input, &textInput.Config{
+		Header:      ui.TextTitle.Render("Please provide a " + kind + " name"),
+		Placeholder: placeholder,
+		Validation: func(s string) error {
+			matched, _ := regexp.Match("^[a-zA-Z0-9_-]+$", []byte(s))
+			if !matched {
+				return fmt.Errorf("Invalid %s name", kind)
+			}
+			return nil
+		},
+	}))
+	if _, err := p.Run(); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	return input
+}
+
+// getComponentBaseName returns the name without the suffix, e.g. ProductRepository -> Product
+func getComponentBaseName(input, suffix string) string {
+	name := utils.ToPascalCase(strings.TrimSuffix(filepath.Base(input), ".go"))
+	return strings.TrimSuffix(name, suffix)
+}
+
+func createRepository(tx *generator.Transaction, currentWorkingDir *string, input string, model *modelBinding) (*generatedComponent, error) {
+	name := getComponentBaseName(input, "Repository") + "Repository"
+
+	type RepositoryData struct {
+		PackageName string        // repositories
+		Name        string        // ProductRepository
+		StructName  string        // productRepository
+		ReciverName string        // r
+		Model       *modelBinding // from --model
+	}
+
+	return createComponent(tx, currentWorkingDir, "repositories", "repository/repository.go.tmpl", name, func(c *generatedComponent) interface{} {
+		return &RepositoryData{
+			PackageName: c.PackageName,
+			Name:        name,
+			StructName:  c.Variable,
+			ReciverName: "r",
+			Model:       model,
+		}
+	})
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/ui/textInput"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var makeRequestCmd = &cobra.Command{
+	Use:   "make:request",
+	Short: "Generate a form request file",
+	Long:  `Generate a form request struct with validation rules, e.g. make:request StoreProductRequest --fields "name:string price:int"`,
+	Run:   generateRequest,
+}
+
+const validatorPackage = "github.com/go-playground/validator/v10"
+
+func init() {
+	rootCmd.AddCommand(makeRequestCmd)
+	makeRequestCmd.Flags().StringP("fields", "f", "", `Fields of the request, e.g. "name:string price:int description:text?"`)
+	addGeneratorFlags(makeRequestCmd)
+	addTemplateFlags(makeRequestCmd)
+}
+
+func generateRequest(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	var input string
+	if len(args) < 1 {
+		p := tea.NewProgram(textInput.InitialTextInputModel(&input, &textInput.Config{
+			Header:      ui.TextTitle.Render("Please provide a request name"),
+			Placeholder: "StoreProductRequest",
+			Validation: func(s string) error {
+				matched, _ := regexp.Match("^[a-zA-Z0-9_/-]+$", []byte(s))
+				if !matched {
+					return fmt.Errorf("Invalid request name")
+				}
+				return nil
+			},
+		}))
+		if _, err := p.Run(); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+	} else {
+		input = args[0]
+	}
+
+	if input == "" {
+		fmt.Println(ui.TextWarning.Render("Request creation has been canceled"))
+		fmt.Println()
+		return
+	}
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	fieldsDefinition, _ := cmd.Flags().GetString("fields")
+	fields, err := utils.ParseFields(fieldsDefinition)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	tx := newTransaction(cmd, currentWorkingDir)
+	if _, err := createRequest(tx, &currentWorkingDir, input, fields); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			fmt.Println(ui.TextError.Render(rollbackErr.Error()))
+		}
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	printGeneratedFiles(tx)
+
+	if !tx.DryRun() {
+		printValidatorHint(currentWorkingDir)
+	}
+}
+
+type generatedRequest struct {
+	Name        string // StoreProductRequest
+	PackageName string // requests
+	ImportPath  string // bykevin.work/refiber/app/requests
+}
+
+func getRequestsDirPath(currentWorkingDir *string) string {
+	return filepath.Join(*currentWorkingDir, "app", "requests")
+}
+
+// createRequest renders the request template into app/requests, creating the
+// shared validator file of the package when it does not exist yet
+func createRequest(tx *generator.Transaction, currentWorkingDir *string, input string, fields []*utils.Field) (*generatedRequest, error) {
+	parts := strings.Split(strings.Trim(filepath.ToSlash(input), "/"), "/")
+
+	name := utils.ToPascalCase(strings.TrimSuffix(parts[len(parts)-1], ".go"))
+	if !strings.HasSuffix(name, "Request") {
+		name += "Request"
+	}
+
+	rDirPath := filepath.Join(getRequestsDirPath(currentWorkingDir), filepath.Join(parts[:len(parts)-1]...))
+	moduleName, err := utils.GetModuleName(*currentWorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	packageName := createPackageName(utils.GetLastPathName(filepath.ToSlash(rDirPath)))
+
+	type RequestData struct {
+		PackageName string         // requests
+		RequestName string         // StoreProductRequest
+		ReciverName string         // r
+		Fields      []*utils.Field // from --fields
+	}
+
+	data := &RequestData{
+		PackageName: packageName,
+		RequestName: name,
+		ReciverName: "r",
+		Fields:      fields,
+	}
+
+	if !tx.Exists(filepath.Join(rDirPath, "validator.go")) {
+		if err := renderTemplateFile(tx, currentWorkingDir, "request/validator.go.tmpl", data, filepath.Join(rDirPath, "validator.go")); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := renderTemplateFile(tx, currentWorkingDir, "request/request.go.tmpl", data, filepath.Join(rDirPath, name+".go")); err != nil {
+		return nil, err
+	}
+
+	rel, err := filepath.Rel(*currentWorkingDir, rDirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &generatedRequest{
+		Name:        name,
+		PackageName: packageName,
+		ImportPath:  path.Join(moduleName, filepath.ToSlash(rel)),
+	}, nil
+}
+
+// getOrCreateRequest reuses a request of the requests package when it already exists
+func getOrCreateRequest(tx *generator.Transaction, currentWorkingDir *string, name string, fields []*utils.Field) (*generatedRequest, error) {
+	if tx.Force() || !tx.Exists(filepath.Join(getRequestsDirPath(currentWorkingDir), name+".go")) {
+		return createRequest(tx, currentWorkingDir, name, fields)
+	}
+
+	moduleName, err := utils.GetModuleName(*currentWorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &generatedRequest{
+		Name:        name,
+		PackageName: "requests",
+		ImportPath:  path.Join(moduleName, "app", "requests"),
+	}, nil
+}
+
+func renderTemplateFile(tx *generator.Transaction, currentWorkingDir *string, templateName string, data interface{}, filePath string) error {
+	buf, err := renderTemplate(currentWorkingDir, templateName, data, filePath)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(filePath, buf)
+}
+
+func printValidatorHint(currentWorkingDir string) {
+	if utils.HasModuleDependency(currentWorkingDir, validatorPackage) {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("  " + ui.TextGreen.Render("go") + " " + ui.TextGray.Render("get "+validatorPackage))
+}
@@ -0,0 +1,535 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/ui/textInput"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var makeResourceCmd = &cobra.Command{
+	Use:   "make:resource",
+	Short: "Generate a model, migration, controller, requests, routes and pages",
+	Long: `Generate a complete CRUD resource, e.g. make:resource Product --fields "name:string price:int"
+
+Every file is created in a single transaction, when one of the steps fails
+all created files are removed and the modified files are restored.`,
+	Run: generateResource,
+}
+
+func init() {
+	rootCmd.AddCommand(makeResourceCmd)
+	makeResourceCmd.Flags().StringP("fields", "f", "", `Fields of the resource, e.g. "name:string price:int description:text?"`)
+	makeResourceCmd.Flags().String("package", "", "Folder in app/controllers where the controller is saved")
+	makeResourceCmd.Flags().Bool("no-pages", false, "Skip the frontend pages")
+	makeResourceCmd.Flags().String("framework", "", "Frontend framework of the pages (react, vue or svelte), detected from package.json by default")
+	makeResourceCmd.Flags().String("lang", "", "Language of the pages (ts or js), detected from the project by default")
+	addGeneratorFlags(makeResourceCmd)
+	addTemplateFlags(makeResourceCmd)
+}
+
+func generateResource(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	var input string
+	if len(args) < 1 {
+		p := tea.NewProgram(textInput.InitialTextInputModel(&input, &textInput.Config{
+			Header:      ui.TextTitle.Render("Please provide a resource name"),
+			Placeholder: "Product",
+			Validation: func(s string) error {
+				matched, _ := regexp.Match("^[a-zA-Z0-9_-]+$", []byte(s))
+				if !matched {
+					return fmt.Errorf("Invalid resource name")
+				}
+				return nil
+			},
+		}))
+		if _, err := p.Run(); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+	} else {
+		input = args[0]
+	}
+
+	if input == "" {
+		fmt.Println(ui.TextWarning.Render("Resource creation has been canceled"))
+		fmt.Println()
+		return
+	}
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	if _, err := utils.GetRefiberTemplateDirPath(&currentWorkingDir); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	fieldsDefinition, _ := cmd.Flags().GetString("fields")
+	fields, err := utils.ParseFields(fieldsDefinition)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	packageName, _ := cmd.Flags().GetString("package")
+	if packageName == "" {
+		if packageName, err = defaultControllerPackage(&currentWorkingDir); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+	}
+
+	var fe *utils.Frontend
+	if noPages, _ := cmd.Flags().GetBool("no-pages"); !noPages {
+		if fe, err = getFrontend(cmd, currentWorkingDir); err != nil {
+			fmt.Println(ui.TextWarning.Render(err.Error() + ", skipping the pages"))
+			fe = nil
+		}
+	}
+
+	name := utils.ToPascalCase(utils.Singularize(input))
+
+	tx := newTransaction(cmd, currentWorkingDir)
+	if err := createResource(tx, &currentWorkingDir, name, packageName, fields, fe); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			fmt.Println(ui.TextError.Render(rollbackErr.Error()))
+		}
+		cobra.CheckErr(ui.TextError.Render(err.Error() + ", all changes have been rolled back"))
+	}
+
+	printGeneratedFiles(tx)
+
+	if !tx.DryRun() && hasWrittenFiles(tx) {
+		fmt.Println(ui.TextGreen.Render(name + " resource successfully created!"))
+		printValidatorHint(currentWorkingDir)
+	}
+}
+
+// hasWrittenFiles reports whether the transaction did more than skipping existing files
+func hasWrittenFiles(tx *generator.Transaction) bool {
+	for _, f := range tx.Files() {
+		if f.Action != generator.Skipped {
+			return true
+		}
+	}
+
+	return false
+}
+
+// createResource runs every generator of a resource in the transaction
+func createResource(tx *generator.Transaction, currentWorkingDir *string, name, packageName string, fields []*utils.Field, fe *utils.Frontend) error {
+	if err := createModel(tx, currentWorkingDir, name, fields); err != nil {
+		return err
+	}
+
+	if err := createMigration(tx, currentWorkingDir, name, fields); err != nil {
+		return err
+	}
+
+	controllerName := name + "Controller"
+
+	requests, err := createControllerRequests(tx, currentWorkingDir, controllerName, fields)
+	if err != nil {
+		return err
+	}
+
+	controller, err := createController(tx, currentWorkingDir, &controllerOptions{
+		Name:        controllerName,
+		DirPath:     filepath.Join(*getControllersDirPath(currentWorkingDir), packageName),
+		PackageName: packageName,
+		Crud:        true,
+		Requests:    requests,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := addResourceRoutes(tx, currentWorkingDir, controller); err != nil {
+		return err
+	}
+
+	if fe != nil {
+		if err := createResourcePages(tx, currentWorkingDir, fe, controller, fields); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// defaultControllerPackage returns the only folder of app/controllers, or web when there are several
+func defaultControllerPackage(currentWorkingDir *string) (string, error) {
+	folders, err := utils.ListFolders(*getControllersDirPath(currentWorkingDir))
+	if err != nil {
+		return "", err
+	}
+
+	if len(*folders) == 1 {
+		return *(*folders)[0], nil
+	}
+
+	for _, folder := range *folders {
+		if *folder == "web" {
+			return *folder, nil
+		}
+	}
+
+	return "", fmt.Errorf("unable to choose a controller folder, use the --package flag")
+}
+
+func createModel(tx *generator.Transaction, currentWorkingDir *string, name string, fields []*utils.Field) error {
+	imports := []string{"time"}
+	for _, f := range fields {
+		if f.GoType == "json.RawMessage" {
+			imports = append([]string{"encoding/json"}, imports...)
+			break
+		}
+	}
+
+	type ModelData struct {
+		PackageName string         // models
+		ModelName   string         // Product
+		TableName   string         // products
+		Imports     []string       // time
+		Fields      []*utils.Field // from --fields
+	}
+
+	data := &ModelData{
+		PackageName: "models",
+		ModelName:   name,
+		TableName:   utils.ToSnakeCase(utils.Pluralize(name)),
+		Imports:     imports,
+		Fields:      fields,
+	}
+
+	return renderTemplateFile(tx, currentWorkingDir, "model/model.go.tmpl", data, filepath.Join(*currentWorkingDir, "app", "models", name+".go"))
+}
+
+func createMigration(tx *generator.Transaction, currentWorkingDir *string, name string, fields []*utils.Field) error {
+	driver := detectDatabaseDriver(*currentWorkingDir)
+	tableName := utils.ToSnakeCase(utils.Pluralize(name))
+
+	idColumns := map[string]string{
+		"mysql":    "BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY",
+		"postgres": "BIGSERIAL PRIMARY KEY",
+		"sqlite":   "INTEGER PRIMARY KEY AUTOINCREMENT",
+	}
+
+	var columns []string
+	for _, f := range fields {
+		column := f.JSONName + " " + f.SQLType(driver)
+		if !f.Optional {
+			column += " NOT NULL"
+		}
+		columns = append(columns, column)
+	}
+
+	type MigrationData struct {
+		TableName string   // products
+		IDColumn  string   // BIGSERIAL PRIMARY KEY
+		Columns   []string // name VARCHAR(255) NOT NULL
+	}
+
+	data := &MigrationData{
+		TableName: tableName,
+		IDColumn:  idColumns[driver],
+		Columns:   columns,
+	}
+
+	migrationsDirPath := filepath.Join(*currentWorkingDir, "database", "migrations")
+	prefix := time.Now().Format("20060102150405") + "_create_" + tableName + "_table"
+
+	// reuse the migration creating the table, it is skipped like the other existing files
+	existing, err := filepath.Glob(filepath.Join(migrationsDirPath, "*_create_"+tableName+"_table.up.sql"))
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		sort.Strings(existing)
+		prefix = strings.TrimSuffix(filepath.Base(existing[0]), ".up.sql")
+	}
+
+	for _, direction := range []string{"up", "down"} {
+		err := renderTemplateFile(tx, currentWorkingDir, "migration/create_table."+direction+".sql.tmpl", data, filepath.Join(migrationsDirPath, prefix+"."+direction+".sql"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// detectDatabaseDriver reads DB_CONNECTION or DB_DRIVER from the env files, mysql by default
+func detectDatabaseDriver(projectPath string) string {
+	for _, envFile := range []string{".env", ".env.example"} {
+		file, err := os.Open(filepath.Join(projectPath, envFile))
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			key, value, found := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+			if !found || (key != "DB_CONNECTION" && key != "DB_DRIVER") {
+				continue
+			}
+
+			value = strings.ToLower(strings.Trim(value, `"' `))
+			switch {
+			case strings.HasPrefix(value, "postgres"), value == "pgsql":
+				file.Close()
+				return "postgres"
+			case strings.HasPrefix(value, "sqlite"):
+				file.Close()
+				return "sqlite"
+			}
+		}
+		file.Close()
+	}
+
+	return "mysql"
+}
+
+// addResourceRoutes registers the resource actions of the controller in the routes
+// folder, in the function where the other routes are registered
+func addResourceRoutes(tx *generator.Transaction, currentWorkingDir *string, controller *generatedController) error {
+	routesFilePath, err := findRoutesFile(*currentWorkingDir, controller.PackageName)
+	if err != nil {
+		return err
+	}
+
+	src, err := tx.ReadFile(routesFilePath)
+	if err != nil {
+		return err
+	}
+
+	routePath := "/" + utils.ToKebabCase(utils.Pluralize(controller.MethodName))
+
+	registered, err := areResourceRoutesRegistered(*currentWorkingDir, controller, routePath)
+	if err != nil {
+		return err
+	}
+	if registered {
+		return nil
+	}
+
+	out, err := insertResourceRoutes(tx.Rel(routesFilePath), src, controller, routePath)
+	if err != nil {
+		return err
+	}
+
+	return tx.Modify(routesFilePath, out)
+}
+
+// insertResourceRoutes registers the routes of the resource actions at the end of the routes function
+// of the source, before its return statement
+func insertResourceRoutes(filename string, src []byte, controller *generatedController, routePath string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	fn, router := findRouterFunc(file)
+	if fn == nil {
+		return nil, fmt.Errorf("unable to find where the routes are registered in %s", filename)
+	}
+
+	constructor, err := controllerConstructorCall(controller, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	variable := utils.GetLowercaseFirstChar(controller.Name)
+
+	var code strings.Builder
+	code.WriteString(fmt.Sprintf("\n\t%s := %s\n", variable, constructor))
+	for _, action := range utils.ResourceActions {
+		method := strings.ToUpper(action.Method[:1]) + strings.ToLower(action.Method[1:])
+		code.WriteString(fmt.Sprintf("\t%s.%s(%q, %s.%s)\n", router, method, routePath+action.Path, variable, action.Name))
+	}
+
+	// the routes go after the last statement before the return, e.g. return r
+	offset := fset.Position(fn.Body.Rbrace).Offset
+	if count := len(fn.Body.List); count > 0 {
+		if _, ok := fn.Body.List[count-1].(*ast.ReturnStmt); ok {
+			offset = fset.Position(fn.Body.Lbrace).Offset + 1
+			if count > 1 {
+				offset = fset.Position(fn.Body.List[count-2].End()).Offset
+			}
+			code.WriteString("\n")
+		}
+	}
+
+	var out bytes.Buffer
+	out.Write(src[:offset])
+	if offset > 0 && src[offset-1] != '\n' {
+		out.WriteString("\n")
+	}
+	out.WriteString(code.String())
+	out.Write(src[offset:])
+
+	fset = token.NewFileSet()
+	file, err = parser.ParseFile(fset, filename, out.Bytes(), parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	astutil.AddImport(fset, file, controller.ImportPath)
+
+	var formatted bytes.Buffer
+	if err := format.Node(&formatted, fset, file); err != nil {
+		return nil, err
+	}
+
+	return formatted.Bytes(), nil
+}
+
+// areResourceRoutesRegistered reports whether a route of the controller or of the resource path is registered
+func areResourceRoutesRegistered(projectPath string, controller *generatedController, routePath string) (bool, error) {
+	routes, err := utils.ParseRoutes(projectPath)
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range routes {
+		if r.Controller == controller.PackageName+"."+controller.Name || (r.Method == "GET" && r.Path == routePath) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func findRoutesFile(projectPath, packageName string) (string, error) {
+	routesDirPath := filepath.Join(projectPath, "routes")
+
+	for _, name := range []string{packageName + ".go", "web.go", "routes.go"} {
+		p := filepath.Join(routesDirPath, name)
+		if utils.DoesDirectoryOrFileExist(p) {
+			return p, nil
+		}
+	}
+
+	entries, err := os.ReadDir(routesDirPath)
+	if err != nil {
+		return "", fmt.Errorf("routes folder not found. Make sure you are inside the Refiber project")
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".go") && !strings.HasSuffix(entry.Name(), "_test.go") {
+			return filepath.Join(routesDirPath, entry.Name()), nil
+		}
+	}
+
+	return "", fmt.Errorf("no routes file found in the routes folder")
+}
+
+// findRouterFunc returns the first function registering routes and the name of its router
+func findRouterFunc(file *ast.File) (*ast.FuncDecl, string) {
+	methods := map[string]bool{"Get": true, "Post": true, "Put": true, "Patch": true, "Delete": true}
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		var router string
+		for _, stmt := range fn.Body.List {
+			expr, ok := stmt.(*ast.ExprStmt)
+			if !ok {
+				continue
+			}
+			call, ok := expr.X.(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			// r.Get("/", web.HomeController).Name("home")
+			for {
+				sel, ok := call.Fun.(*ast.SelectorExpr)
+				if !ok {
+					break
+				}
+				if ident, ok := sel.X.(*ast.Ident); ok && methods[sel.Sel.Name] {
+					router = ident.Name
+					break
+				}
+				inner, ok := sel.X.(*ast.CallExpr)
+				if !ok {
+					break
+				}
+				call = inner
+			}
+			if router != "" {
+				return fn, router
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+// controllerConstructorCall builds the constructor call of the controller, passing the
+// parameters of the routes function with the same types, e.g. web.NewProductController(s)
+func controllerConstructorCall(controller *generatedController, routerFn *ast.FuncDecl) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", controller.Source, 0)
+	if err != nil {
+		return "", err
+	}
+
+	constructorName := "New" + controller.Name
+
+	var constructor *ast.FuncDecl
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == constructorName {
+			constructor = fn
+		}
+	}
+	if constructor == nil {
+		return "", fmt.Errorf("the controller template has no %s function", constructorName)
+	}
+
+	available := map[string]string{}
+	for _, param := range routerFn.Type.Params.List {
+		for _, name := range param.Names {
+			available[types.ExprString(param.Type)] = name.Name
+		}
+	}
+
+	var args []string
+	for _, param := range constructor.Type.Params.List {
+		paramType := types.ExprString(param.Type)
+		arg, ok := available[paramType]
+		if !ok {
+			return "", fmt.Errorf("unable to pass %s to %s from the routes function", paramType, constructorName)
+		}
+
+		count := len(param.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			args = append(args, arg)
+		}
+	}
+
+	return fmt.Sprintf("%s.%s(%s)", controller.PackageName, constructorName, strings.Join(args, ", ")), nil
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+func TestInsertResourceRoutes(t *testing.T) {
+	controller := &generatedController{
+		Name:        "ProductController",
+		MethodName:  "Product",
+		PackageName: "web",
+		ImportPath:  "example.com/app/app/controllers/web",
+		Source: []byte(`package web
+
+func NewProductController(s support.Refiber) *ProductController {
+	return &ProductController{support: s}
+}
+`),
+	}
+
+	cases := []struct {
+		name   string
+		src    string
+		last   string // the last statement of the routes function
+		routes int    // statements before the routes of the resource
+	}{
+		{
+			name: "without return",
+			src: `package routes
+
+func SetupRouter(r router.RouterInterface, s support.Refiber) {
+	r.Get("/", web.HomeController)
+}
+`,
+			last:   `productController.Destroy`,
+			routes: 1,
+		},
+		{
+			name: "returning the router",
+			src: `package routes
+
+func SetupRouter(r router.RouterInterface, s support.Refiber) router.RouterInterface {
+	r.Get("/", web.HomeController)
+
+	// the router of the app
+	return r
+}
+`,
+			last:   `return r`,
+			routes: 1,
+		},
+		{
+			name: "returning after an if",
+			src: `package routes
+
+func SetupRouter(r router.RouterInterface, s support.Refiber) error {
+	r.Get("/", web.HomeController)
+	if s == nil {
+		return nil
+	}
+	return nil
+}
+`,
+			last:   `return nil`,
+			routes: 2,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := insertResourceRoutes("routes/web.go", []byte(tt.src), controller, "/products")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "routes/web.go", out, 0)
+			if err != nil {
+				t.Fatalf("the routes don't parse: %v\n%s", err, out)
+			}
+
+			fn := file.Decls[len(file.Decls)-1].(*ast.FuncDecl)
+			var stmts []string
+			for _, stmt := range fn.Body.List {
+				stmts = append(stmts, statementString(stmt))
+			}
+
+			want := []string{
+				"productController := web.NewProductController(s)",
+				`r.Get("/products", productController.Index)`,
+				`r.Get("/products/create", productController.Create)`,
+				`r.Post("/products", productController.Store)`,
+				`r.Get("/products/:id", productController.Show)`,
+				`r.Get("/products/:id/edit", productController.Edit)`,
+				`r.Put("/products/:id", productController.Update)`,
+				`r.Delete("/products/:id", productController.Destroy)`,
+			}
+			if len(stmts) < tt.routes+len(want) {
+				t.Fatalf("the routes function has %d statements, want at least %d\n%s", len(stmts), tt.routes+len(want), out)
+			}
+			if got := strings.Join(stmts[tt.routes:tt.routes+len(want)], "\n"); got != strings.Join(want, "\n") {
+				t.Errorf("inserted\n%s\nwant\n%s", got, strings.Join(want, "\n"))
+			}
+			if last := stmts[len(stmts)-1]; !strings.Contains(last, tt.last) {
+				t.Errorf("the routes function ends with %q, want %q\n%s", last, tt.last, out)
+			}
+			if !strings.Contains(string(out), `"example.com/app/app/controllers/web"`) {
+				t.Errorf("the controller package isn't imported\n%s", out)
+			}
+		})
+	}
+}
+
+func statementString(stmt ast.Stmt) string {
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		return types.ExprString(s.X)
+	case *ast.AssignStmt:
+		return types.ExprString(s.Lhs[0]) + " " + s.Tok.String() + " " + types.ExprString(s.Rhs[0])
+	case *ast.ReturnStmt:
+		return "return " + types.ExprString(s.Results[0])
+	}
+
+	return "if"
+}
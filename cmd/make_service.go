@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var makeServiceCmd = &cobra.Command{
+	Use:   "make:service [name]",
+	Short: "Generate a Service interface and implementation",
+	Long: `Generate a service interface and its implementation in app/services, e.g. make:service Product --model Product --repository.
+The service receives the repository of the same name when it exists, and is registered in the container of app/container.
+The container isn't wired into the app, build it in main.go with container.New and pass it to the routes.`,
+	Run: generateService,
+}
+
+func init() {
+	rootCmd.AddCommand(makeServiceCmd)
+	makeServiceCmd.Flags().StringP("model", "m", "", "Bind the service to a model of app/models, e.g. Product")
+	makeServiceCmd.Flags().BoolP("repository", "r", false, "Create the repository of the service too")
+	makeServiceCmd.Flags().Bool("no-container", false, "Don't register the service in the container")
+	addGeneratorFlags(makeServiceCmd)
+	addTemplateFlags(makeServiceCmd)
+}
+
+func generateService(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	input := getComponentName(args, "service", "Product")
+	if input == "" {
+		fmt.Println(ui.TextWarning.Render("Service creation has been canceled"))
+		fmt.Println()
+		return
+	}
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	modelName, _ := cmd.Flags().GetString("model")
+	model, err := getModelBinding(&currentWorkingDir, modelName)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	useRepository, _ := cmd.Flags().GetBool("repository")
+	noContainer, _ := cmd.Flags().GetBool("no-container")
+
+	tx := newTransaction(cmd, currentWorkingDir)
+	handleErr := func(err error) {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			fmt.Println(ui.TextError.Render(rollbackErr.Error()))
+		}
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	baseName := getComponentBaseName(input, "Service")
+
+	var repository *generatedComponent
+	if useRepository {
+		if repository, err = createRepository(tx, &currentWorkingDir, baseName, model); err != nil {
+			handleErr(err)
+		}
+	} else if repository, err = findRepository(tx, &currentWorkingDir, baseName+"Repository"); err != nil {
+		handleErr(err)
+	}
+
+	if repository != nil && !noContainer {
+		if err := addToContainer(tx, &currentWorkingDir, repository); err != nil {
+			handleErr(err)
+		}
+	}
+
+	service, err := createService(tx, &currentWorkingDir, baseName, model, repository)
+	if err != nil {
+		handleErr(err)
+	}
+
+	if !noContainer {
+		if err := addToContainer(tx, &currentWorkingDir, service); err != nil {
+			handleErr(err)
+		}
+	}
+
+	printGeneratedFiles(tx)
+	printContainerHint(tx, &currentWorkingDir)
+}
+
+// findRepository returns the existing repository of app/repositories, or nil
+func findRepository(tx *generator.Transaction, currentWorkingDir *string, name string) (*generatedComponent, error) {
+	filePath := filepath.Join(*currentWorkingDir, "app", "repositories", name+".go")
+	if !tx.Exists(filePath) {
+		return nil, nil
+	}
+
+	source, err := tx.ReadFile(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	moduleName, err := utils.GetModuleName(*currentWorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &generatedComponent{
+		Name:        name,
+		PackageName: "repositories",
+		ImportPath:  path.Join(moduleName, "app", "repositories"),
+		Type:        "repositories." + name,
+		Variable:    utils.GetLowercaseFirstChar(name),
+		Source:      source,
+	}, nil
+}
+
+func createService(tx *generator.Transaction, currentWorkingDir *string, baseName string, model *modelBinding, repository *generatedComponent) (*generatedComponent, error) {
+	name := baseName + "Service"
+
+	type ServiceData struct {
+		PackageName string              // services
+		Name        string              // ProductService
+		StructName  string              // productService
+		ReciverName string              // svc
+		Model       *modelBinding       // from --model
+		Repository  *generatedComponent // the repository of the same name
+	}
+
+	return createComponent(tx, currentWorkingDir, "services", "service/service.go.tmpl", name, func(c *generatedComponent) interface{} {
+		return &ServiceData{
+			PackageName: c.PackageName,
+			Name:        name,
+			StructName:  c.Variable,
+			ReciverName: "svc",
+			Model:       model,
+			Repository:  repository,
+		}
+	})
+}
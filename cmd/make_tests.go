@@ -0,0 +1,347 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/ui/textInput"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var makeTestCmd = &cobra.Command{
+	Use:   "make:test [controller]",
+	Short: "Generate the test of a controller",
+	Long: `Generate a table driven test of a controller, e.g. make:test web/ProductController.
+Each case calls a route of the controller through the routes of the project with app.Test,
+CRUD controllers get one case per resource action.`,
+	Run: generateTest,
+}
+
+func init() {
+	rootCmd.AddCommand(makeTestCmd)
+	addGeneratorFlags(makeTestCmd)
+	addTemplateFlags(makeTestCmd)
+}
+
+func generateTest(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	var input string
+	if len(args) < 1 {
+		p := tea.NewProgram(textInput.InitialTextInputModel(&input, &textInput.Config{
+			Header:      ui.TextTitle.Render("Please provide a controller name"),
+			Placeholder: "ProductController",
+			Validation: func(s string) error {
+				matched, _ := regexp.Match("^[a-zA-Z0-9_/-]+$", []byte(s))
+				if !matched {
+					return fmt.Errorf("Invalid controller name")
+				}
+				return nil
+			},
+		}))
+		if _, err := p.Run(); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+	} else {
+		input = args[0]
+	}
+
+	if input == "" {
+		fmt.Println(ui.TextWarning.Render("Test creation has been canceled"))
+		fmt.Println()
+		return
+	}
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	controller, err := findController(&currentWorkingDir, input)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	tx := newTransaction(cmd, currentWorkingDir)
+	if err := createControllerTest(tx, &currentWorkingDir, controller); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			fmt.Println(ui.TextError.Render(rollbackErr.Error()))
+		}
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	printGeneratedFiles(tx)
+}
+
+// findController finds an existing controller, e.g. web/ProductController or Product
+func findController(currentWorkingDir *string, input string) (*generatedController, error) {
+	name, dirPath, err := getControllerNameAndPath(input, currentWorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if dirPath == nil {
+		folders, err := utils.ListFolders(*getControllersDirPath(currentWorkingDir))
+		if err != nil {
+			return nil, err
+		}
+
+		var found []string
+		for _, folder := range *folders {
+			p := filepath.Join(*getControllersDirPath(currentWorkingDir), *folder)
+			if utils.DoesDirectoryOrFileExist(filepath.Join(p, *name+".go")) {
+				found = append(found, p)
+			}
+		}
+
+		switch len(found) {
+		case 0:
+			return nil, fmt.Errorf("the %s.go doesn't exist in app/controllers", *name)
+		case 1:
+			dirPath = &found[0]
+		default:
+			return nil, fmt.Errorf("the %s.go exists in several folders, use e.g. %s/%s", *name, utils.GetLastPathName(filepath.ToSlash(found[0])), *name)
+		}
+	}
+
+	source, err := os.ReadFile(filepath.Join(*dirPath, *name+".go"))
+	if err != nil {
+		return nil, fmt.Errorf("the %s.go doesn't exist", *name)
+	}
+
+	moduleName, err := utils.GetModuleName(*currentWorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := filepath.Rel(*currentWorkingDir, *dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &generatedController{
+		Name:        *name,
+		MethodName:  strings.TrimSuffix(*name, "Controller"),
+		PackageName: createPackageName(utils.GetLastPathName(filepath.ToSlash(*dirPath))),
+		DirPath:     *dirPath,
+		ImportPath:  path.Join(moduleName, filepath.ToSlash(rel)),
+		Source:      source,
+	}, nil
+}
+
+type testCase struct {
+	Name   string // Index
+	Method string // GET
+	Path   string // /products/1
+	Status string // StatusOK
+	Body   string // {"name":"test"}, a JSON body passing the validation of the request of the action
+}
+
+// createControllerTest renders the test of the controller next to it, and the tests package
+// building the app when the project doesn't have it yet
+func createControllerTest(tx *generator.Transaction, currentWorkingDir *string, controller *generatedController) error {
+	appImportPath, err := createTestApp(tx, currentWorkingDir)
+	if err != nil {
+		return err
+	}
+
+	type TestData struct {
+		PackageName    string      // web
+		ControllerName string      // ProductController
+		AppImportPath  string      // bykevin.work/refiber/tests
+		AppPackageName string      // tests
+		Cases          []*testCase // one case per route of the controller
+	}
+
+	data := &TestData{
+		PackageName:    controller.PackageName,
+		ControllerName: controller.Name,
+		AppImportPath:  appImportPath,
+		AppPackageName: path.Base(appImportPath),
+		Cases:          controllerTestCases(tx, *currentWorkingDir, controller),
+	}
+
+	return renderTemplateFile(tx, currentWorkingDir, "test/controller_test.go.tmpl", data, filepath.Join(controller.DirPath, controller.Name+"_test.go"))
+}
+
+// createTestApp creates tests/app.go building the app like the main package of the project
+func createTestApp(tx *generator.Transaction, currentWorkingDir *string) (string, error) {
+	moduleName, err := utils.GetModuleName(*currentWorkingDir)
+	if err != nil {
+		return "", err
+	}
+
+	importPath := moduleName + "/tests"
+	appFilePath := filepath.Join(*currentWorkingDir, "tests", "app.go")
+	if tx.Exists(appFilePath) {
+		return importPath, nil
+	}
+
+	type AppData struct {
+		PackageName string   // tests
+		Source      string   // main.go
+		Imports     []string // imports of the main package file
+		Statements  string   // statements of the main package building the app
+		AppVariable string   // app
+	}
+
+	data := &AppData{PackageName: "tests"}
+
+	bootstrap, err := utils.FindAppBootstrap(*currentWorkingDir)
+	if err != nil {
+		fmt.Println(ui.TextWarning.Render(err.Error() + ", register the routes in tests/app.go"))
+	} else {
+		data.Source = filepath.ToSlash(bootstrap.File)
+		data.Statements = bootstrap.Statements
+		data.AppVariable = bootstrap.AppVariable
+		for _, spec := range bootstrap.Imports {
+			if spec != `"github.com/gofiber/fiber/v2"` {
+				data.Imports = append(data.Imports, spec)
+			}
+		}
+	}
+
+	if err := renderTemplateFile(tx, currentWorkingDir, "test/app.go.tmpl", data, appFilePath); err != nil {
+		return "", err
+	}
+
+	return importPath, nil
+}
+
+var routeParamRegex = regexp.MustCompile(`:[^/]+|\*`)
+
+// controllerTestCases returns a case per registered route of the controller, or per resource
+// action when the routes of a CRUD controller are not registered yet
+func controllerTestCases(tx *generator.Transaction, projectPath string, controller *generatedController) []*testCase {
+	var cases []*testCase
+
+	routes, _ := utils.ParseRoutes(projectPath)
+	for _, r := range routes {
+		if r.Controller != controller.PackageName+"."+controller.Name {
+			continue
+		}
+
+		action := r.Handler[strings.LastIndex(r.Handler, ".")+1:]
+		method := r.Method
+		if method == "ALL" {
+			method = "GET"
+		}
+
+		cases = append(cases, newTestCase(action, method, r.Path))
+	}
+
+	if len(cases) == 0 && isCrudController(controller.Source) {
+		for _, action := range utils.ResourceActions {
+			cases = append(cases, newTestCase(action.Name, action.Method, utils.RoutePath(controller.MethodName)+action.Path))
+		}
+	}
+
+	if len(cases) == 0 {
+		cases = append(cases, newTestCase(controller.Name, "GET", "/"+utils.ToKebabCase(controller.MethodName)))
+	}
+
+	for _, c := range cases {
+		c.Body = requestBody(tx, projectPath, controller, c.Name)
+	}
+
+	// the case names must be unique, e.g. a controller registered on several routes
+	seen := map[string]int{}
+	for _, c := range cases {
+		seen[c.Name]++
+	}
+	for _, c := range cases {
+		if seen[c.Name] > 1 {
+			c.Name += " " + c.Method + " " + c.Path
+		}
+	}
+
+	return cases
+}
+
+func newTestCase(action, method, routePath string) *testCase {
+	status := "StatusOK"
+	if action == "Store" {
+		// the CRUD controller redirects after storing
+		status = "StatusFound"
+	}
+
+	return &testCase{
+		Name:   action,
+		Method: method,
+		Path:   routeParamRegex.ReplaceAllString(routePath, "1"),
+		Status: status,
+	}
+}
+
+// requestBody returns a JSON body passing the validation of the request parsed by the action,
+// or an empty body when the action doesn't parse a request
+func requestBody(tx *generator.Transaction, projectPath string, controller *generatedController, action string) string {
+	importPath, name, ok := utils.ActionRequest(controller.Source, action)
+	if !ok {
+		return ""
+	}
+
+	moduleName, err := utils.GetModuleName(projectPath)
+	if err != nil || !strings.HasPrefix(importPath, moduleName+"/") {
+		return ""
+	}
+	dirPath := filepath.Join(projectPath, filepath.FromSlash(strings.TrimPrefix(importPath, moduleName+"/")))
+
+	// the request is usually declared in a file named after it
+	filePaths := []string{filepath.Join(dirPath, name+".go")}
+	if entries, err := os.ReadDir(dirPath); err == nil {
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".go") && !strings.HasSuffix(entry.Name(), "_test.go") {
+				filePaths = append(filePaths, filepath.Join(dirPath, entry.Name()))
+			}
+		}
+	}
+
+	for _, filePath := range filePaths {
+		source, err := tx.ReadFile(filePath)
+		if err != nil {
+			continue
+		}
+
+		fields, found := utils.ParseRequestFields(source, name)
+		if !found {
+			continue
+		}
+
+		body := map[string]any{}
+		for _, field := range fields {
+			if !field.Optional() {
+				body[field.Name] = field.SampleValue()
+			}
+		}
+
+		b, err := json.Marshal(body)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+
+	return ""
+}
+
+// isCrudController reports whether the controller has every resource action method
+func isCrudController(source []byte) bool {
+	for _, action := range utils.ResourceActions {
+		if !regexp.MustCompile(`func \([^)]*\) ` + action.Name + `\(`).Match(source) {
+			return false
+		}
+	}
+
+	return true
+}
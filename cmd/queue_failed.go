@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+)
+
+var queueFailedCmd = &cobra.Command{
+	Use:   "queue:failed",
+	Short: "List the failed jobs",
+	Args:  cobra.NoArgs,
+	Run:   listFailedJobs,
+}
+
+func init() {
+	rootCmd.AddCommand(queueFailedCmd)
+	queueFailedCmd.Flags().String("driver", "", "Queue driver registered in app/jobs, QUEUE_DRIVER or file by default")
+	queueFailedCmd.Flags().Bool("json", false, "Output the failed jobs as JSON")
+}
+
+type failedJob struct {
+	ID       string          `json:"id"`
+	Job      string          `json:"job"`
+	Payload  json.RawMessage `json:"payload"`
+	Attempts int             `json:"attempts"`
+	Error    string          `json:"error"`
+	FailedAt *time.Time      `json:"failed_at"`
+}
+
+func listFailedJobs(cmd *cobra.Command, args []string) {
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	data, err := getJobsHarnessData(currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	driver, _ := cmd.Flags().GetString("driver")
+
+	var out bytes.Buffer
+	exitCode, err := runHarness(currentWorkingDir, "jobs/harness.go.tmpl", data, []string{"-failed", "-driver=" + driver}, &out)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+	if exitCode != 0 {
+		os.Exit(exitCode)
+	}
+
+	if asJSON, _ := cmd.Flags().GetBool("json"); asJSON {
+		fmt.Print(out.String())
+		return
+	}
+
+	var jobs []*failedJob
+	if err := json.Unmarshal(out.Bytes(), &jobs); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	fmt.Println()
+
+	if len(jobs) == 0 {
+		fmt.Println(ui.TextGreen.Render("No failed jobs"))
+		fmt.Println()
+		return
+	}
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(ui.TextGray).
+		Headers("ID", "JOB", "ATTEMPTS", "FAILED AT", "ERROR").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 1)
+			if row == 0 {
+				return style.Bold(true)
+			}
+			if col == 4 {
+				return style.Inherit(ui.TextError)
+			}
+			return style
+		})
+
+	for _, job := range jobs {
+		failedAt := ""
+		if job.FailedAt != nil {
+			failedAt = job.FailedAt.Local().Format(time.DateTime)
+		}
+		t.Row(job.ID, job.Job, strconv.Itoa(job.Attempts), failedAt, job.Error)
+	}
+
+	fmt.Println(t.Render())
+	fmt.Println()
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+)
+
+var queueWorkCmd = &cobra.Command{
+	Use:   "queue:work",
+	Short: "Handle the queued jobs",
+	Long: `Run a worker handling the jobs of app/jobs until it is interrupted.
+A failed job is retried with an exponential backoff, and moved to the failed jobs after the last attempt.`,
+	Args: cobra.NoArgs,
+	Run:  workQueue,
+}
+
+func init() {
+	rootCmd.AddCommand(queueWorkCmd)
+	queueWorkCmd.Flags().String("driver", "", "Queue driver registered in app/jobs, QUEUE_DRIVER or file by default")
+	queueWorkCmd.Flags().Int("tries", 3, "Attempts of a job before it fails")
+	queueWorkCmd.Flags().Duration("backoff", 10*time.Second, "Delay before the first retry, doubled on each attempt")
+	queueWorkCmd.Flags().Duration("sleep", 3*time.Second, "Wait when the queue is empty")
+	queueWorkCmd.Flags().Bool("once", false, "Stop when the queue is empty")
+}
+
+func workQueue(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	data, err := getJobsHarnessData(currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	driver, _ := cmd.Flags().GetString("driver")
+	tries, _ := cmd.Flags().GetInt("tries")
+	backoff, _ := cmd.Flags().GetDuration("backoff")
+	sleep, _ := cmd.Flags().GetDuration("sleep")
+	once, _ := cmd.Flags().GetBool("once")
+
+	workerArgs := []string{
+		"-driver=" + driver,
+		"-tries=" + strconv.Itoa(tries),
+		"-backoff=" + backoff.String(),
+		"-sleep=" + sleep.String(),
+		"-once=" + strconv.FormatBool(once),
+	}
+
+	fmt.Println(ui.TextGreen.Render("Processing jobs") + ui.TextGray.Render(", press Ctrl+C to stop"))
+	fmt.Println()
+
+	exitCode, err := runHarness(currentWorkingDir, "jobs/harness.go.tmpl", data, workerArgs, os.Stdout)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	os.Exit(exitCode)
+}
@@ -4,16 +4,30 @@ Copyright © 2024 Refiber adam@bykevin.work
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
 )
 
+// projectDir is the folder of the --project flag
+var projectDir string
+
+// configFile is the configuration file of the --config flag
+var configFile string
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "refiber-cli",
 	Short: "A CLI for Refiber",
 	Long:  "A CLI for Refiber",
+
+	PersistentPreRun:  preRun,
+	PersistentPostRun: printUpdateNotice,
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -26,13 +40,93 @@ func Execute() {
 }
 
 func init() {
-	// Here you will define your flags and configuration settings.
-	// Cobra supports persistent flags, which, if defined here,
-	// will be global for your application.
+	rootCmd.Version = utils.GetBuildInfo().String()
+	rootCmd.SetVersionTemplate("refiber-cli {{.Version}}\n")
+
+	rootCmd.PersistentFlags().StringVar(&projectDir, "project", "", "Folder of the Refiber project (default is the project of the current folder)")
+	rootCmd.PersistentFlags().BoolVar(&noInput, "no-input", false, "Run without interaction, e.g. in scripts (disables the update check, also disabled by "+utils.NoUpdateCheckEnv+"=1 and in CI)")
+	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "Configuration file of the user (default is refiber/config.yaml in the user config folder)")
+	rootCmd.PersistentFlags().String("theme", "", "Color theme of the output: default, light or monochrome (default is the ui.theme setting)")
+}
+
+// preRun loads the configuration and starts the update check before every command
+func preRun(cmd *cobra.Command, args []string) {
+	if err := loadConfig(cmd); err != nil {
+		// the config commands fix the configuration, they run without the invalid values
+		if !strings.HasPrefix(cmd.Name(), "config:") {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		fmt.Fprintln(os.Stderr, ui.TextWarning.Render(err.Error()+", ignoring it"))
+	}
 
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.refiber.yaml)")
+	startUpdateCheck(cmd, args)
+}
+
+// loadConfig sets the configuration of the command and prints the settings it skipped
+func loadConfig(cmd *cobra.Command) error {
+	config, err := resolveConfig(cmd)
+	if config == nil {
+		return err
+	}
+	utils.SetConfig(config)
+	if themeErr := ui.SetTheme(config.Get(utils.ConfigTheme)); themeErr != nil {
+		return themeErr
+	}
+
+	for _, warning := range config.Warnings() {
+		fmt.Fprintln(os.Stderr, ui.TextWarning.Render(warning))
+	}
+
+	return err
+}
+
+// resolveConfig resolves the configuration of the command: the defaults, the user configuration,
+// the refiber.yaml of the project, the REFIBER_* environment variables and the flags
+func resolveConfig(cmd *cobra.Command) (*utils.Config, error) {
+	userConfigPath := configFile
+	if userConfigPath == "" {
+		p, err := utils.GetUserConfigPath()
+		if err != nil {
+			return nil, err
+		}
+		userConfigPath = p
+	}
+
+	// the commands outside of a project only use the user configuration
+	projectRoot, _ := getProjectDir()
+
+	return utils.LoadConfig(userConfigPath, projectRoot, &configFlags{cmd: cmd})
+}
+
+// configFlags looks up the flags of a command overriding the settings
+type configFlags struct {
+	cmd *cobra.Command
+}
+
+func (f *configFlags) ConfigFlag(name string) (string, bool) {
+	flag := f.cmd.Flags().Lookup(name)
+	if flag == nil || !flag.Changed {
+		return "", false
+	}
+
+	if flag.Value.Type() == "stringArray" {
+		values, _ := f.cmd.Flags().GetStringArray(name)
+		return strings.Join(values, string(os.PathListSeparator)), true
+	}
+
+	return flag.Value.String(), true
+}
+
+// getProjectDir returns the root of the Refiber project of the --project flag or of the current folder
+func getProjectDir() (string, error) {
+	dir := projectDir
+	if dir == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		dir = wd
+	}
 
-	// Cobra also supports local flags, which will only run
-	// when this action is called directly.
-	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	return utils.FindProjectRoot(dir)
 }
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var routeListCmd = &cobra.Command{
+	Use:   "route:list",
+	Short: "List all registered routes",
+	Long:  "List all routes registered in the routes folder by statically analyzing the source code",
+	Args:  cobra.NoArgs,
+	Run:   listRoutes,
+}
+
+func init() {
+	rootCmd.AddCommand(routeListCmd)
+	routeListCmd.Flags().StringP("method", "m", "", "Filter the routes by method")
+	routeListCmd.Flags().StringP("path", "p", "", "Filter the routes by path prefix")
+	routeListCmd.Flags().StringP("controller", "c", "", "Filter the routes by controller name")
+	routeListCmd.Flags().Bool("json", false, "Output the routes as JSON")
+}
+
+func listRoutes(cmd *cobra.Command, args []string) {
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	routes, err := utils.ParseRoutes(currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	method, _ := cmd.Flags().GetString("method")
+	pathPrefix, _ := cmd.Flags().GetString("path")
+	controller, _ := cmd.Flags().GetString("controller")
+	routes = filterRoutes(routes, method, pathPrefix, controller)
+
+	if asJSON, _ := cmd.Flags().GetBool("json"); asJSON {
+		if routes == nil {
+			routes = []*utils.Route{}
+		}
+
+		out, err := json.MarshalIndent(routes, "", "  ")
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		fmt.Println(string(out))
+		return
+	}
+
+	fmt.Println()
+
+	if len(routes) == 0 {
+		fmt.Println(ui.TextWarning.Render("No routes found"))
+		fmt.Println()
+		return
+	}
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(ui.TextGray).
+		Headers("METHOD", "PATH", "HANDLER", "MIDDLEWARE", "LOCATION").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 1)
+			if row == 0 {
+				return style.Bold(true)
+			}
+			if col == 0 {
+				return style.Inherit(ui.TextGreen)
+			}
+			if col == 4 {
+				return style.Inherit(ui.TextGray)
+			}
+			return style
+		})
+
+	// the location is where the route is registered, the handler location is only in the JSON output
+	for _, r := range routes {
+		t.Row(r.Method, r.Path, r.Handler, strings.Join(r.Middleware, ", "), r.Location)
+	}
+
+	fmt.Println(t.Render())
+	fmt.Println(ui.TextGray.PaddingLeft(1).Render(fmt.Sprintf("Showing %d routes", len(routes))))
+	fmt.Println()
+}
+
+func filterRoutes(routes []*utils.Route, method, pathPrefix, controller string) []*utils.Route {
+	var filtered []*utils.Route
+
+	for _, r := range routes {
+		if method != "" && !strings.EqualFold(r.Method, method) {
+			continue
+		}
+		if pathPrefix != "" && !strings.HasPrefix(r.Path, "/"+strings.TrimPrefix(pathPrefix, "/")) {
+			continue
+		}
+		if controller != "" && !strings.Contains(strings.ToLower(r.Controller), strings.ToLower(controller)) {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+
+	return filtered
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"syscall"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/runner"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run [command] [args...]",
+	Short: "Run a console command of the app",
+	Long: `Run a command of app/console, e.g. refiber-cli run reindex:products --dry-run.
+The arguments and flags after the name are passed to the command. Without a name the commands are listed.`,
+	Run: runConsoleCommand,
+}
+
+func init() {
+	rootCmd.AddCommand(runCmd)
+	// the flags of refiber-cli are only read before the name of the command,
+	// the flags after it belong to the console command
+	runCmd.Flags().SetInterspersed(false)
+}
+
+func runConsoleCommand(cmd *cobra.Command, args []string) {
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	commands, err := utils.ParseConsoleCommands(currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	if len(args) == 0 {
+		listConsoleCommands(commands)
+		return
+	}
+
+	var command *utils.ConsoleCommand
+	for _, c := range commands {
+		if c.Name == args[0] {
+			command = c
+		}
+	}
+	if command == nil {
+		cobra.CheckErr(ui.TextError.Render(fmt.Sprintf("the %s command doesn't exist, create it with make:command", args[0])))
+	}
+
+	moduleName, err := utils.GetModuleName(currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	data := struct {
+		PackageName string
+		ImportPath  string
+		TypeName    string
+	}{
+		PackageName: "console",
+		ImportPath:  path.Join(moduleName, "app", "console"),
+		TypeName:    command.TypeName,
+	}
+
+	exitCode, err := runHarness(currentWorkingDir, "console/harness.go.tmpl", data, args[1:], os.Stdout)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	os.Exit(exitCode)
+}
+
+func listConsoleCommands(commands []*utils.ConsoleCommand) {
+	fmt.Println()
+
+	if len(commands) == 0 {
+		fmt.Println(ui.TextWarning.Render("No commands found, create one with make:command"))
+		fmt.Println()
+		return
+	}
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(ui.TextGray).
+		Headers("COMMAND", "DESCRIPTION", "LOCATION").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			style := lipgloss.NewStyle().Padding(0, 1)
+			if row == 0 {
+				return style.Bold(true)
+			}
+			if col == 0 {
+				return style.Inherit(ui.TextGreen)
+			}
+			if col == 2 {
+				return style.Inherit(ui.TextGray)
+			}
+			return style
+		})
+
+	for _, c := range commands {
+		t.Row(c.Name, c.Description, c.Location)
+	}
+
+	fmt.Println(t.Render())
+	fmt.Println()
+}
+
+// runHarness renders a temporary main package and runs it with go run from the
+// project folder, it returns the exit code of the program
+func runHarness(currentWorkingDir string, templateName string, data interface{}, args []string, stdout io.Writer) (int, error) {
+	// a dot folder is ignored by ./... patterns
+	harnessDirPath, err := os.MkdirTemp(currentWorkingDir, ".refiber-run-")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(harnessDirPath)
+
+	harnessFilePath := filepath.Join(harnessDirPath, "main.go")
+	src, err := renderTemplate(&currentWorkingDir, templateName, data, harnessFilePath)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.WriteFile(harnessFilePath, src, 0644); err != nil {
+		return 0, err
+	}
+
+	goRun := &runner.Command{
+		Name:   "go",
+		Args:   append([]string{"run", "./" + filepath.Base(harnessDirPath)}, args...),
+		Dir:    currentWorkingDir,
+		Stdin:  os.Stdin,
+		Stdout: stdout,
+		Stderr: os.Stderr,
+	}
+
+	// the program receives the interrupt signals of the terminal, refiber-cli waits for it
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	if _, err := runner.Run(context.Background(), goRun); err != nil {
+		var runErr *runner.Error
+		if errors.As(err, &runErr) && runErr.ExitCode > 0 {
+			return runErr.ExitCode, nil
+		}
+		return 0, err
+	}
+
+	return 0, nil
+}
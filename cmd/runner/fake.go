@@ -0,0 +1,75 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Fake records the commands instead of running them, e.g. in the tests of update:
+//
+//	fake := &runner.Fake{}
+//	runner.Default = fake
+//	defer func() { runner.Default = runner.New() }()
+type Fake struct {
+	// Handler returns the result of a command, every command succeeds without output by default
+	Handler func(cmd *Command) (*Result, error)
+
+	mu    sync.Mutex
+	calls []*Command
+}
+
+func (f *Fake) Run(ctx context.Context, cmd *Command) (*Result, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, cmd)
+	f.mu.Unlock()
+
+	if f.Handler == nil {
+		return &Result{}, nil
+	}
+
+	result, err := f.Handler(cmd)
+	if result == nil {
+		result = &Result{}
+	}
+
+	if cmd.Stdout != nil {
+		cmd.Stdout.Write(result.Stdout)
+	}
+	if cmd.Stderr != nil {
+		cmd.Stderr.Write(result.Stderr)
+	}
+	if cmd.OnLine != nil {
+		for _, output := range []struct {
+			content []byte
+			stderr  bool
+		}{{result.Stdout, false}, {result.Stderr, true}} {
+			lines := &lineWriter{onLine: func(line string) { cmd.OnLine(line, output.stderr) }}
+			lines.Write(output.content)
+			lines.flush()
+		}
+	}
+
+	return result, err
+}
+
+// Calls returns the command lines run so far
+func (f *Fake) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var lines []string
+	for _, cmd := range f.calls {
+		lines = append(lines, cmd.CommandLine())
+	}
+
+	return lines
+}
+
+// Fail returns a handler failing the commands with the stderr and exit code
+func Fail(stderr string, exitCode int) func(cmd *Command) (*Result, error) {
+	return func(cmd *Command) (*Result, error) {
+		result := &Result{Stderr: []byte(stderr), ExitCode: exitCode}
+		return result, NewError(cmd, result, fmt.Errorf("exit status %d", exitCode))
+	}
+}
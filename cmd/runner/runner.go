@@ -0,0 +1,224 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// stderrTailLines is the number of lines of the output kept in the errors
+const stderrTailLines = 20
+
+// Command is an external command run by a Runner
+type Command struct {
+	Name    string
+	Args    []string
+	Dir     string
+	Env     []string // KEY=value added to the environment of refiber-cli
+	Timeout time.Duration
+	Stdin   io.Reader
+
+	// Stdout and Stderr receive the output while it is captured, e.g. os.Stdout to stream it
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// OnLine is called with every output line, e.g. to show the progress in a TUI
+	OnLine func(line string, stderr bool)
+}
+
+// Result is the captured output of a command
+type Result struct {
+	Stdout   []byte
+	Stderr   []byte
+	ExitCode int
+	Duration time.Duration
+}
+
+// Runner runs external commands, the commands use Default so tests can replace it with a Fake
+type Runner interface {
+	Run(ctx context.Context, cmd *Command) (*Result, error)
+}
+
+// Default is the runner of the package functions
+var Default Runner = New()
+
+// New returns a runner executing the commands with os/exec
+func New() Runner {
+	return &execRunner{}
+}
+
+// Run runs a command with the default runner
+func Run(ctx context.Context, cmd *Command) (*Result, error) {
+	return Default.Run(ctx, cmd)
+}
+
+// Output runs a command with the default runner and returns its trimmed stdout
+func Output(ctx context.Context, cmd *Command) (string, error) {
+	result, err := Default.Run(ctx, cmd)
+	if result == nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(result.Stdout)), err
+}
+
+// Error is returned when a command can not start, fails or times out
+type Error struct {
+	CommandLine string
+	ExitCode    int    // -1 when the command did not exit
+	Tail        string // last lines of stderr, or of stdout when stderr is empty
+	Err         error
+}
+
+func (e *Error) Error() string {
+	msg := e.CommandLine + " failed: " + e.Err.Error()
+	if e.Tail != "" {
+		msg += "\n" + e.Tail
+	}
+
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// CommandLine returns the command as typed in a shell
+func (cmd *Command) CommandLine() string {
+	parts := []string{cmd.Name}
+	for _, arg := range cmd.Args {
+		if arg == "" || strings.ContainsAny(arg, " \t\n\"'\\$") {
+			arg = strconv.Quote(arg)
+		}
+		parts = append(parts, arg)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+type execRunner struct{}
+
+func (r *execRunner) Run(ctx context.Context, cmd *Command) (*Result, error) {
+	if cmd.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cmd.Timeout)
+		defer cancel()
+	}
+
+	c := exec.CommandContext(ctx, cmd.Name, cmd.Args...)
+	c.Dir = cmd.Dir
+	c.Stdin = cmd.Stdin
+	if len(cmd.Env) > 0 {
+		c.Env = append(os.Environ(), cmd.Env...)
+	}
+	// the children inheriting the pipes don't block the command after it was killed
+	c.WaitDelay = time.Second
+
+	var stdout, stderr bytes.Buffer
+	stdoutLines := &lineWriter{onLine: func(line string) { cmd.OnLine(line, false) }}
+	stderrLines := &lineWriter{onLine: func(line string) { cmd.OnLine(line, true) }}
+	c.Stdout = outputWriter(&stdout, cmd.Stdout, stdoutLines, cmd.OnLine != nil)
+	c.Stderr = outputWriter(&stderr, cmd.Stderr, stderrLines, cmd.OnLine != nil)
+
+	startedAt := time.Now()
+	err := c.Run()
+
+	if cmd.OnLine != nil {
+		stdoutLines.flush()
+		stderrLines.flush()
+	}
+
+	result := &Result{
+		Stdout:   stdout.Bytes(),
+		Stderr:   stderr.Bytes(),
+		ExitCode: c.ProcessState.ExitCode(),
+		Duration: time.Since(startedAt),
+	}
+
+	if err == nil {
+		return result, nil
+	}
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		if errors.Is(ctxErr, context.DeadlineExceeded) && cmd.Timeout > 0 {
+			err = fmt.Errorf("timed out after %s", cmd.Timeout)
+		} else {
+			err = ctxErr
+		}
+	}
+
+	return result, NewError(cmd, result, err)
+}
+
+// NewError returns the error of a failed command, a Fake uses it to fail like a real command
+func NewError(cmd *Command, result *Result, err error) *Error {
+	e := &Error{CommandLine: cmd.CommandLine(), ExitCode: -1, Err: err}
+	if result == nil {
+		return e
+	}
+
+	e.ExitCode = result.ExitCode
+	output := result.Stderr
+	if len(bytes.TrimSpace(output)) == 0 {
+		output = result.Stdout
+	}
+	e.Tail = tail(string(output), stderrTailLines)
+
+	return e
+}
+
+func outputWriter(capture *bytes.Buffer, stream io.Writer, lines *lineWriter, withLines bool) io.Writer {
+	writers := []io.Writer{capture}
+	if stream != nil {
+		writers = append(writers, stream)
+	}
+	if withLines {
+		writers = append(writers, lines)
+	}
+
+	return io.MultiWriter(writers...)
+}
+
+func tail(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = append([]string{"..."}, lines[len(lines)-n:]...)
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// lineWriter calls onLine with every complete line written to it
+type lineWriter struct {
+	onLine  func(line string)
+	pending []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.pending = append(w.pending, p...)
+
+	for {
+		i := bytes.IndexByte(w.pending, '\n')
+		if i < 0 {
+			break
+		}
+		w.onLine(strings.TrimRight(string(w.pending[:i]), "\r"))
+		w.pending = w.pending[i+1:]
+	}
+
+	return len(p), nil
+}
+
+func (w *lineWriter) flush() {
+	if len(w.pending) > 0 {
+		w.onLine(string(w.pending))
+		w.pending = nil
+	}
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var stubDiffCmd = &cobra.Command{
+	Use:   "stub:diff [templates...]",
+	Short: "Show how the published stubs differ from the upstream templates",
+	Long: `Compare the stubs of the project with the templates of the installed framework and CLI,
+useful to bring the customized stubs up to date after upgrading the framework.`,
+	Run: diffStubs,
+}
+
+func init() {
+	rootCmd.AddCommand(stubDiffCmd)
+	stubDiffCmd.Flags().Bool("global", false, "Compare the global stubs instead of the project stubs")
+}
+
+func diffStubs(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	stubsDirPath, err := getStubsDirPath(cmd, &currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	if !utils.DoesDirectoryOrFileExist(stubsDirPath) {
+		cobra.CheckErr(ui.TextError.Render(fmt.Sprintf("%s not found. Publish the stubs with the stub:publish command", relOrAbs(currentWorkingDir, stubsDirPath))))
+	}
+
+	names, err := utils.ListTemplates(&utils.TemplateSource{FS: os.DirFS(stubsDirPath), Dir: stubsDirPath})
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	upstream := utils.GetUpstreamTemplateSources(&currentWorkingDir)
+
+	changed := 0
+	for _, name := range names {
+		if !matchStubName(name, args) {
+			continue
+		}
+
+		stubContent, err := os.ReadFile(filepath.Join(stubsDirPath, filepath.FromSlash(name)))
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+
+		upstreamContent, source, err := utils.ResolveTemplate(upstream, name)
+		if err != nil {
+			fmt.Println(ui.TextWarning.Render("  no upstream ") + name)
+			continue
+		}
+
+		diff := utils.UnifiedDiff(source.Name+"/"+name, "stubs/"+name, upstreamContent, stubContent)
+		if diff == "" {
+			fmt.Println(ui.TextGray.Render("  identical   ") + name)
+			continue
+		}
+
+		changed++
+		fmt.Println(ui.TextWarning.Render("  differs     ") + name)
+		fmt.Println()
+		fmt.Print(ui.RenderDiff(diff))
+		fmt.Println()
+	}
+
+	fmt.Println()
+	if changed == 0 {
+		fmt.Println(ui.TextGreen.Render("The stubs are identical to the upstream templates"))
+	} else {
+		fmt.Println(fmt.Sprintf("%d stub(s) differ from the upstream templates", changed))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/templates"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var stubPublishCmd = &cobra.Command{
+	Use:   "stub:publish [templates...]",
+	Short: "Publish the generator templates for customization",
+	Long: `Copy the generator templates of the framework and the CLI into the stubs folder of the project.
+The generators look up the templates in order: project stubs, global stubs, vendor and the module cache.
+
+Pass template names or folders, e.g. controller or pages/react.tmpl, to only publish some of them.`,
+	Run: publishStubs,
+}
+
+func init() {
+	rootCmd.AddCommand(stubPublishCmd)
+	stubPublishCmd.Flags().Bool("force", false, "Overwrite the stubs that already exist")
+	stubPublishCmd.Flags().Bool("global", false, "Publish the stubs to the global stubs folder shared by every project")
+}
+
+func publishStubs(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	stubsDirPath, err := getStubsDirPath(cmd, &currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	stubs, err := getUpstreamStubs(&currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	force, _ := cmd.Flags().GetBool("force")
+
+	var names []string
+	for name := range stubs {
+		if matchStubName(name, args) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		cobra.CheckErr(ui.TextError.Render("no templates found to publish"))
+	}
+
+	published := 0
+	for _, name := range names {
+		stubPath := filepath.Join(stubsDirPath, filepath.FromSlash(name))
+
+		if !force && utils.DoesDirectoryOrFileExist(stubPath) {
+			fmt.Println(ui.TextGray.Render("  skipped   ") + name + ui.TextGray.Render(" (already exists)"))
+			continue
+		}
+
+		content, err := fs.ReadFile(stubs[name].FS, name)
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+
+		if err := os.MkdirAll(filepath.Dir(stubPath), 0755); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		if err := os.WriteFile(stubPath, content, 0644); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+
+		fmt.Println(ui.TextGreen.Render("  published ") + name + ui.TextGray.Render(" ("+stubs[name].Name+")"))
+		published++
+	}
+
+	// documents the functions and the data of the templates
+	readmePath := filepath.Join(stubsDirPath, "README.md")
+	if force || !utils.DoesDirectoryOrFileExist(readmePath) {
+		readme, err := templates.FS.ReadFile("README.md")
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		if err := os.WriteFile(readmePath, readme, 0644); err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+	}
+
+	fmt.Println()
+	fmt.Println(fmt.Sprintf("%d stub(s) published to %s", published, ui.TextCyan.Render(relOrAbs(currentWorkingDir, stubsDirPath))))
+	fmt.Println()
+}
+
+// getStubsDirPath returns the project stubs folder or the global one with the --global flag
+func getStubsDirPath(cmd *cobra.Command, currentWorkingDir *string) (string, error) {
+	if global, _ := cmd.Flags().GetBool("global"); global {
+		return utils.GetGlobalStubsDirPath()
+	}
+
+	return utils.GetProjectStubsDirPath(currentWorkingDir), nil
+}
+
+// getUpstreamStubs returns every upstream template by name, the first source containing a template wins
+func getUpstreamStubs(currentWorkingDir *string) (map[string]*utils.TemplateSource, error) {
+	stubs := map[string]*utils.TemplateSource{}
+
+	for _, source := range utils.GetUpstreamTemplateSources(currentWorkingDir) {
+		names, err := utils.ListTemplates(source)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			if _, ok := stubs[name]; !ok {
+				stubs[name] = source
+			}
+		}
+	}
+
+	return stubs, nil
+}
+
+// matchStubName reports whether the template name is one of the filters or inside one of the filtered folders
+func matchStubName(name string, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+
+	for _, filter := range filters {
+		filter = strings.Trim(filepath.ToSlash(filter), "/")
+		if name == filter || strings.HasPrefix(name, filter+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func relOrAbs(base, path string) string {
+	rel, err := filepath.Rel(base, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+
+	return rel
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+// templateValues holds the extra template data of the --set flag
+var templateValues []string
+
+func addTemplateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&templateValues, "set", nil, "Pass extra data to the templates, e.g. --set author=Kevin")
+	cmd.Flags().StringArray("templates", nil, "Folder of templates looked up after the project stubs, can be repeated (default is the templates.sources setting)")
+}
+
+// parseTemplate parses a generator template with the template functions,
+// see cmd/templates/README.md for the data available to each template
+func parseTemplate(currentWorkingDir *string, name string, content []byte) (*template.Template, error) {
+	moduleName, err := utils.GetModuleName(*currentWorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := utils.ParseTemplateValues(templateValues)
+	if err != nil {
+		return nil, err
+	}
+
+	return template.New(name).Funcs(utils.TemplateFuncs(moduleName, values)).Parse(string(content))
+}
+
+// renderTemplate renders a generator template, the Go files are formatted and their
+// imports fixed, a rendered Go file that doesn't parse is reported with its template line
+func renderTemplate(currentWorkingDir *string, templateName string, data interface{}, filePath string) ([]byte, error) {
+	return renderTemplateFrom(currentWorkingDir, utils.GetTemplateSources(currentWorkingDir), templateName, data, filePath)
+}
+
+// renderTemplateFrom renders a generator template of the first source containing it
+func renderTemplateFrom(currentWorkingDir *string, sources []*utils.TemplateSource, templateName string, data interface{}, filePath string) ([]byte, error) {
+	content, source, err := utils.ResolveTemplate(sources, templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := parseTemplate(currentWorkingDir, filepath.Base(filePath), content)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := utils.ExecuteTemplate(tmpl, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if filepath.Ext(filePath) != ".go" {
+		return buf, nil
+	}
+
+	formatted, err := utils.FormatGoSource(filePath, buf)
+	if err != nil {
+		return nil, templateSyntaxError(source, templateName, content, buf, err)
+	}
+
+	return formatted, nil
+}
+
+// templateSyntaxError points the first syntax error of a rendered Go file back to the template
+func templateSyntaxError(source *utils.TemplateSource, templateName string, tmpl, rendered []byte, err error) error {
+	list, ok := utils.SyntaxErrors(err)
+	if !ok || len(list) == 0 {
+		return err
+	}
+
+	templatePath := source.Name + ":" + templateName
+	if source.Dir != "" {
+		templatePath = filepath.Join(source.Dir, filepath.FromSlash(templateName))
+	}
+
+	first := list[0]
+	lines := strings.Split(string(rendered), "\n")
+
+	var line string
+	if first.Pos.Line > 0 && first.Pos.Line <= len(lines) {
+		line = lines[first.Pos.Line-1]
+	}
+
+	location := templatePath
+	if templateLine := utils.FindTemplateLine(tmpl, line); templateLine > 0 {
+		location = fmt.Sprintf("%s:%d", templatePath, templateLine)
+	}
+
+	return fmt.Errorf("%s: the rendered %s doesn't parse at %d:%d: %s\n\n\t%s\n\nno file has been written",
+		location, filepath.Base(first.Pos.Filename), first.Pos.Line, first.Pos.Column, first.Msg, strings.TrimSpace(line))
+}
@@ -0,0 +1,9 @@
+package templates
+
+import "embed"
+
+// FS contains the templates owned by the CLI, the Go templates are owned by the framework.
+// The CRUD controller is the fallback of the framework templates not handling the requests
+//
+//go:embed README.md controller pages request model migration test repository service container console jobs
+var FS embed.FS
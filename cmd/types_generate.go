@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var typesGenerateCmd = &cobra.Command{
+	Use:   "types:generate",
+	Short: "Generate TypeScript types for page props",
+	Long: `Generate TypeScript types for page props from Go structs.
+
+Structs passed as props to a page, e.g. Render(c, "Products/Index", props), are emitted as
+interfaces together with every type they reference. Mark other structs with a
+"//refiber:props" comment to always emit them.`,
+	Args: cobra.NoArgs,
+	Run:  generateTypes,
+}
+
+func init() {
+	rootCmd.AddCommand(typesGenerateCmd)
+	typesGenerateCmd.Flags().StringP("output", "o", "", "Output file (default is types/pages.d.ts next to the pages folder)")
+	typesGenerateCmd.Flags().BoolP("watch", "w", false, "Regenerate the types when a Go file changes")
+}
+
+func generateTypes(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	output, _ := cmd.Flags().GetString("output")
+	if output == "" {
+		fe, err := utils.DetectFrontend(currentWorkingDir)
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		output = filepath.Join(filepath.Dir(fe.PagesDirPath), "types", "pages.d.ts")
+	} else if !filepath.IsAbs(output) {
+		output = filepath.Join(currentWorkingDir, output)
+	}
+
+	if err := writeTypes(currentWorkingDir, output); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	if watch, _ := cmd.Flags().GetBool("watch"); !watch {
+		return
+	}
+
+	fmt.Println(ui.TextGray.Render("watching for changes, press ctrl+c to stop"))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	isGoFile := func(path string) bool {
+		return strings.HasSuffix(path, ".go") && !strings.HasSuffix(path, "_test.go")
+	}
+
+	err = utils.WatchFiles(ctx, currentWorkingDir, time.Second, isGoFile, func() {
+		if err := writeTypes(currentWorkingDir, output); err != nil {
+			fmt.Println(ui.TextError.Render(err.Error()))
+		}
+	})
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+}
+
+func writeTypes(projectPath, output string) error {
+	content, err := utils.GenerateTypeScript(projectPath)
+	if err != nil {
+		return err
+	}
+
+	rel, err := filepath.Rel(projectPath, output)
+	if err != nil {
+		rel = output
+	}
+
+	if existing, err := os.ReadFile(output); err == nil && bytes.Equal(existing, content) {
+		fmt.Println(ui.TextGray.Render(rel + " is up to date"))
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(output), 0755); err != nil {
+		return err
+	}
+
+	if err := utils.WriteFile(filepath.Base(output), filepath.Dir(output), content); err != nil {
+		return err
+	}
+
+	fmt.Println(ui.TextGreen.Render(rel + " successfully generated!"))
+	return nil
+}
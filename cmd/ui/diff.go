@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RenderDiff colors the lines of a unified diff
+func RenderDiff(diff string) string {
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+
+	// keep the tabs of the source, lipgloss converts them to spaces by default
+	style := func(s lipgloss.Style) lipgloss.Style {
+		return s.Copy().TabWidth(lipgloss.NoTabConversion)
+	}
+
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			lines[i] = style(TextGray).Bold(true).Render(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = style(TextCyan).Render(line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = style(TextGreen).Render(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = style(TextError).Render(line)
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
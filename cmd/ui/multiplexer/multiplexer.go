@@ -0,0 +1,143 @@
+package multiplexer
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+)
+
+// LineMsg is an output line of a process, printed above the status bar
+type LineMsg struct {
+	Process string
+	Line    string
+}
+
+// StatusMsg updates the status of a process in the status bar
+type StatusMsg struct {
+	Process string
+	Status  string
+	Failed  bool
+}
+
+// StoppedMsg quits the program once every process has stopped
+type StoppedMsg struct{}
+
+var colors = []lipgloss.Color{"6", "5", "3", "4", "2"}
+
+type process struct {
+	name   string
+	style  lipgloss.Style
+	status string
+	failed bool
+}
+
+type model struct {
+	names     []string
+	processes []*process
+	width     int
+	stopping  bool
+	onStop    func()
+}
+
+// InitialMultiplexerModel shows the output of the processes with a colored prefix,
+// onStop is called when the user presses ctrl+c, the program quits with a StoppedMsg
+func InitialMultiplexerModel(names []string, onStop func()) model {
+	m := model{names: names, onStop: onStop}
+
+	for i, name := range names {
+		m.processes = append(m.processes, &process{name: name, style: processStyle(i), status: "starting"})
+	}
+
+	return m
+}
+
+// Prefix returns the colored prefix of the lines of a process, used by the plain output too
+func Prefix(names []string, name string) string {
+	prefixLen := 0
+	index := 0
+	for i, n := range names {
+		if len(n) > prefixLen {
+			prefixLen = len(n)
+		}
+		if n == name {
+			index = i
+		}
+	}
+
+	return processStyle(index).Render(name+strings.Repeat(" ", prefixLen-len(name))) + ui.TextGray.Render(" | ")
+}
+
+func processStyle(index int) lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(colors[index%len(colors)]).Bold(true)
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			if !m.stopping {
+				m.stopping = true
+				m.onStop()
+			}
+		}
+
+	case LineMsg:
+		return m, tea.Println(Prefix(m.names, msg.Process) + msg.Line)
+
+	case StatusMsg:
+		if p := m.find(msg.Process); p != nil {
+			p.status, p.failed = msg.Status, msg.Failed
+		}
+
+	case StoppedMsg:
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+func (m model) View() string {
+	var parts []string
+	for _, p := range m.processes {
+		status := ui.TextGreen.Render(p.status)
+		if p.failed {
+			status = ui.TextError.Render(p.status)
+		} else if p.status != "running" {
+			status = ui.TextWarning.Render(p.status)
+		}
+		parts = append(parts, p.style.Render(p.name)+" "+status)
+	}
+
+	hint := "ctrl+c to stop"
+	if m.stopping {
+		hint = "stopping..."
+	}
+
+	bar := strings.Join(parts, ui.TextGray.Render("  ·  ")) + ui.TextGray.Render("  ·  "+hint)
+	if m.width > 0 {
+		bar = lipgloss.NewStyle().MaxWidth(m.width).Render(bar)
+	}
+
+	return "\n" + bar
+}
+
+func (m model) find(name string) *process {
+	for _, p := range m.processes {
+		if p.name == name {
+			return p
+		}
+	}
+
+	return nil
+}
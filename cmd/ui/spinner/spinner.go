@@ -1,6 +1,8 @@
 package spinner
 
 import (
+	"strings"
+
 	sp "github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -8,13 +10,22 @@ import (
 
 type errMsg error
 
+// StatusMsg shows the last output line of the running work next to the title
+type StatusMsg string
+
+// maxStatusWidth keeps the status on the line of the spinner
+const maxStatusWidth = 72
+
 type model struct {
 	spinner  sp.Model
 	title    *string
+	status   string
 	quitting bool
 	err      error
 }
 
+var statusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
 func InitialSpinnerModel(title string) model {
 	s := sp.New()
 	s.Spinner = sp.Line
@@ -33,6 +44,13 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.err = msg
 		return m, nil
 
+	case StatusMsg:
+		m.status = strings.TrimSpace(string(msg))
+		if runes := []rune(m.status); len(runes) > maxStatusWidth {
+			m.status = string(runes[:maxStatusWidth-1]) + "…"
+		}
+		return m, nil
+
 	default:
 		var cmd tea.Cmd
 		m.spinner, cmd = m.spinner.Update(msg)
@@ -45,6 +63,9 @@ func (m model) View() string {
 		return m.err.Error()
 	}
 	str := " " + m.spinner.View() + " " + *m.title
+	if m.status != "" {
+		str += " " + statusStyle.Render(m.status)
+	}
 	if m.quitting {
 		return str + "\n"
 	}
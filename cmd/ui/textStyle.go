@@ -1,6 +1,11 @@
 package ui
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
 
 var (
 	TextWarning = lipgloss.NewStyle().Foreground(lipgloss.Color("#f39c11"))
@@ -8,4 +13,24 @@ var (
 	TextTitle   = lipgloss.NewStyle().Background(lipgloss.Color("#01FAC6")).Foreground(lipgloss.Color("#030303")).Bold(true).Padding(0, 1, 0)
 	TextGreen   = lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
 	TextGray    = lipgloss.NewStyle().Foreground(lipgloss.Color("#626262"))
+	TextCyan    = lipgloss.NewStyle().Foreground(lipgloss.Color("6"))
 )
+
+// SetTheme changes the styles to a color theme: default, light or monochrome
+func SetTheme(name string) error {
+	switch name {
+	case "", "default":
+	case "light":
+		// darker colors, readable on a light background
+		TextWarning = TextWarning.Copy().Foreground(lipgloss.Color("#a65c00"))
+		TextError = TextError.Copy().Foreground(lipgloss.Color("#c62828"))
+		TextTitle = TextTitle.Copy().Background(lipgloss.Color("#00796b")).Foreground(lipgloss.Color("#ffffff"))
+		TextGray = TextGray.Copy().Foreground(lipgloss.Color("#4a4a4a"))
+	case "monochrome":
+		lipgloss.SetColorProfile(termenv.Ascii)
+	default:
+		return fmt.Errorf("unknown theme %s, use default, light or monochrome", name)
+	}
+
+	return nil
+}
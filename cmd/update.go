@@ -1,69 +1,216 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/refiber/refiber-cli/cmd/runner"
 	"github.com/refiber/refiber-cli/cmd/ui"
 	"github.com/refiber/refiber-cli/cmd/ui/spinner"
 	"github.com/refiber/refiber-cli/cmd/utils"
 	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
 )
 
-/**
- * TODO: check latest version and print it, but if possible
- * if current version is the same with the latest then print it
- */
-
 var updateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update refiber cli",
-	Run:   update,
+	Long: `Update refiber-cli to the latest version, showing the changelog since the installed version.
+Use --version to install a specific version, e.g. to roll back to an older one.
+
+refiber-cli is installed with go install when it was installed that way, otherwise the release
+binary of the platform is downloaded, verified with the checksums of the release and replaces
+the executable. The replaced executable is kept, use --rollback to restore it.`,
+	Args: cobra.NoArgs,
+	Run:  update,
 }
 
 func init() {
 	rootCmd.AddCommand(updateCmd)
+	updateCmd.Flags().String("version", "", "Version to install, e.g. v1.2.0 (default is the latest version)")
+	updateCmd.Flags().Bool("rollback", false, "Restore the executable replaced by the last update")
+	updateCmd.Flags().Bool("binary", false, "Download the release binary even when go is installed")
 }
 
 func update(cmd *cobra.Command, args []string) {
 	fmt.Println()
 
-	spinner := tea.NewProgram(spinner.InitialSpinnerModel("updating..."))
+	info := utils.GetBuildInfo()
+
+	method, err := utils.DetectInstallMethod()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+	if method.IsPackageManager() {
+		fmt.Println(ui.TextWarning.Render("refiber-cli was installed with " + method.Name + ", update it with: " + method.UpdateHint))
+		fmt.Println()
+		return
+	}
+
+	if rollback, _ := cmd.Flags().GetBool("rollback"); rollback {
+		rollbackCLI(method, info)
+		return
+	}
+
+	target, _ := cmd.Flags().GetString("version")
+	if target == "" {
+		latest, err := utils.LatestCLIVersion()
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		target = latest
+	} else if !strings.HasPrefix(target, "v") {
+		target = "v" + target
+	}
+	if !semver.IsValid(target) {
+		cobra.CheckErr(ui.TextError.Render(fmt.Sprintf("invalid version %s, use e.g. v1.2.0", target)))
+	}
+
+	if isCurrentVersion(info.Version, target) {
+		fmt.Println(ui.TextGreen.Render("refiber-cli " + target + " is already installed"))
+		fmt.Println()
+		return
+	}
+
+	current := info.Version
+	if current == "" {
+		current = "dev"
+	}
+	fmt.Println(ui.TextTitle.Render("Updating refiber-cli") + " " + ui.TextGray.Render(current+" → "+target))
+	fmt.Println()
+
+	if info.Version != "" && semver.Compare(target, info.Version) < 0 {
+		fmt.Println(ui.TextWarning.Render("Rolling back to " + target))
+		fmt.Println()
+	} else {
+		printChangelog(info.Version, target)
+	}
+
+	// the release binary replaces the executable when go install can not
+	binary, _ := cmd.Flags().GetBool("binary")
+	binary = binary || method.Name != utils.InstallMethodGo || !utils.HasGoToolchain()
+
+	if err := utils.BackupExecutable(method.Executable, current); err != nil {
+		fmt.Println(ui.TextWarning.Render("Unable to back up the executable, --rollback will not be available: " + err.Error()))
+		fmt.Println()
+	}
+
+	progress := tea.NewProgram(spinner.InitialSpinnerModel("updating..."))
 
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		if _, err := spinner.Run(); err != nil {
+		if _, err := progress.Run(); err != nil {
 			cobra.CheckErr(ui.TextError.Render(err.Error()))
 		}
 	}()
-	defer utils.DeferTeaPanicHandler(spinner)
+	defer utils.DeferTeaPanicHandler(progress)
 
-	if err := installLatestCLI(); err != nil {
+	// the output of go install, e.g. go: downloading ..., is shown next to the spinner
+	install := func(version string) error {
+		return installCLI(version, func(line string) {
+			progress.Send(spinner.StatusMsg(line))
+		})
+	}
+	if binary {
+		install = func(version string) error {
+			return installCLIBinary(version, method.Executable)
+		}
+	}
+	if err := install(target); err != nil {
+		progress.ReleaseTerminal()
 		cobra.CheckErr(ui.TextError.Render(err.Error()))
 	}
 
-	if releaseErr := spinner.ReleaseTerminal(); releaseErr != nil {
+	if releaseErr := progress.ReleaseTerminal(); releaseErr != nil {
 		fmt.Printf("Problem releasing terminal: %v", releaseErr)
 	}
 
-	fmt.Println(ui.TextGreen.PaddingLeft(1).Render("update complete!"))
+	fmt.Println(ui.TextGreen.PaddingLeft(1).Render("refiber-cli " + target + " installed!"))
+	fmt.Println(ui.TextGray.PaddingLeft(1).Render("Use update --rollback to restore " + current))
+}
+
+// rollbackCLI swaps the executable with the one replaced by the last update
+func rollbackCLI(method *utils.InstallMethod, info *utils.BuildInfo) {
+	backupVersion, err := utils.GetBackupVersion()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	current := info.Version
+	if current == "" {
+		current = "dev"
+	}
+
+	if err := utils.RestoreBackup(method.Executable, current); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	fmt.Println(ui.TextGreen.Render("refiber-cli rolled back to " + backupVersion))
+	fmt.Println(ui.TextGray.Render("Use update --rollback again to restore " + current))
+	fmt.Println()
+}
+
+// isCurrentVersion reports whether the installed version is the version, a development build never is
+func isCurrentVersion(installed, version string) bool {
+	return installed != "" && semver.Compare(installed, version) == 0
 }
 
-func installLatestCLI() error {
-	currentWorkingDir, err := os.Getwd()
+// printChangelog prints the release notes of the versions after the installed one
+func printChangelog(installed, target string) {
+	if installed == "" {
+		fmt.Println(ui.TextGray.Render("See the changes at " + utils.CLIReleasesURL))
+		fmt.Println()
+		return
+	}
 
+	releases, err := utils.GetCLIChangelog(installed, target)
+	if err != nil || len(releases) == 0 {
+		fmt.Println(ui.TextGray.Render("See the changes at " + utils.CLIReleasesURL))
+		fmt.Println()
+		return
+	}
+
+	for _, r := range releases {
+		fmt.Println(ui.TextCyan.Bold(true).Render(r.Version) + " " + ui.TextGray.Render(r.URL))
+		for _, line := range strings.Split(strings.TrimSpace(strings.ReplaceAll(r.Notes, "\r\n", "\n")), "\n") {
+			fmt.Println("  " + line)
+		}
+		fmt.Println()
+	}
+}
+
+// installCLIBinary replaces the executable with the verified release binary of the platform
+func installCLIBinary(version, exe string) error {
+	binPath, err := utils.DownloadReleaseBinary(version, exe)
 	if err != nil {
 		return err
 	}
 
-	sourceURL := "github.com/refiber/refiber-cli@latest"
-	if err = utils.ExecuteCmd("go", []string{"install", sourceURL}, currentWorkingDir); err != nil {
+	if err := utils.ReplaceExecutable(exe, binPath); err != nil {
+		os.Remove(binPath)
 		return err
 	}
 
 	return nil
 }
+
+// installCLI installs the version with go install, onLine receives its output lines
+func installCLI(version string, onLine func(line string)) error {
+	_, err := runner.Run(context.Background(), &runner.Command{
+		Name:    "go",
+		Args:    []string{"install", utils.CLIModulePath + "@" + version},
+		Timeout: 5 * time.Minute,
+		OnLine: func(line string, stderr bool) {
+			onLine(line)
+		},
+	})
+
+	return err
+}
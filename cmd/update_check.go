@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+	"golang.org/x/term"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+// noInput disables the interactions that are not required by the command, e.g. the update check
+var noInput bool
+
+// updateCheckSkippedCommands check the versions themselves or are run by scripts
+var updateCheckSkippedCommands = map[string]bool{
+	"update":           true,
+	"version":          true,
+	"help":             true,
+	"completion":       true,
+	"__complete":       true,
+	"__completeNoDesc": true,
+}
+
+// updateCheckWait is how long the notice waits for a check still running when the command finishes
+const updateCheckWait = time.Second
+
+type updateNotifier struct {
+	cached    *utils.UpdateCheck
+	framework string // framework version required by the project
+	done      chan *utils.UpdateCheck
+}
+
+var notifier *updateNotifier
+
+// startUpdateCheck checks the latest versions in the background, at most once per utils.UpdateCheckInterval
+func startUpdateCheck(cmd *cobra.Command, args []string) {
+	if !shouldCheckForUpdates(cmd) {
+		return
+	}
+
+	cached, err := utils.ReadUpdateCheck()
+	if err != nil {
+		return
+	}
+
+	notifier = &updateNotifier{cached: cached}
+	if dir, err := getProjectDir(); err == nil {
+		// a replaced framework is not upgraded with refiber-cli upgrade
+		notifier.framework, _ = getFrameworkRequirement(dir)
+	}
+
+	if !cached.IsDue() {
+		return
+	}
+
+	notifier.done = make(chan *utils.UpdateCheck, 1)
+	go func() {
+		check, err := utils.RefreshUpdateCheck(cached)
+		if err != nil {
+			check = nil
+		}
+		notifier.done <- check
+	}()
+}
+
+// printUpdateNotice prints a one-line notice when refiber-cli or the framework of the project is outdated
+func printUpdateNotice(cmd *cobra.Command, args []string) {
+	if notifier == nil {
+		return
+	}
+
+	check := notifier.cached
+	if notifier.done != nil {
+		select {
+		case refreshed := <-notifier.done:
+			if refreshed != nil {
+				check = refreshed
+			}
+		case <-time.After(updateCheckWait):
+		}
+	}
+
+	var updates []string
+	if installed := utils.GetBuildInfo().Version; installed != "" && isNewerVersion(check.CLI, installed) {
+		updates = append(updates, fmt.Sprintf("refiber-cli %s → %s (refiber-cli update)", installed, check.CLI))
+	}
+	if isNewerVersion(check.Framework, notifier.framework) {
+		updates = append(updates, fmt.Sprintf("framework %s → %s (refiber-cli upgrade)", notifier.framework, check.Framework))
+	}
+	if len(updates) == 0 {
+		return
+	}
+
+	fmt.Fprintln(os.Stderr, ui.TextWarning.Render("Update available: "+strings.Join(updates, ", ")))
+}
+
+func shouldCheckForUpdates(cmd *cobra.Command) bool {
+	if noInput || utils.IsUpdateCheckDisabled() || updateCheckSkippedCommands[cmd.Name()] {
+		return false
+	}
+
+	// the output is read by another program
+	if asJSON, err := cmd.Flags().GetBool("json"); err == nil && asJSON {
+		return false
+	}
+
+	return term.IsTerminal(int(os.Stderr.Fd()))
+}
+
+// isNewerVersion reports whether latest is a release newer than current
+func isNewerVersion(latest, current string) bool {
+	return semver.IsValid(latest) && semver.IsValid(current) && semver.Compare(latest, current) > 0
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/refiber/refiber-cli/cmd/runner"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+func TestInstallCLI(t *testing.T) {
+	cases := []struct {
+		name     string
+		handler  func(cmd *runner.Command) (*runner.Result, error)
+		lines    []string // lines shown next to the spinner
+		exitCode int
+	}{
+		{
+			name: "installed",
+			handler: func(cmd *runner.Command) (*runner.Result, error) {
+				return &runner.Result{Stderr: []byte("go: downloading github.com/refiber/refiber-cli v1.2.0\ngo: downloading github.com/spf13/cobra v1.8.0\n")}, nil
+			},
+			lines: []string{
+				"go: downloading github.com/refiber/refiber-cli v1.2.0",
+				"go: downloading github.com/spf13/cobra v1.8.0",
+			},
+		},
+		{
+			name:     "go install fails",
+			handler:  runner.Fail("go: github.com/refiber/refiber-cli@v1.2.0: invalid version", 1),
+			lines:    []string{"go: github.com/refiber/refiber-cli@v1.2.0: invalid version"},
+			exitCode: 1,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &runner.Fake{Handler: tt.handler}
+			runner.Default = fake
+			defer func() { runner.Default = runner.New() }()
+
+			var lines []string
+			err := installCLI("v1.2.0", func(line string) {
+				lines = append(lines, line)
+			})
+
+			want := []string{"go install " + utils.CLIModulePath + "@v1.2.0"}
+			if calls := fake.Calls(); strings.Join(calls, "\n") != strings.Join(want, "\n") {
+				t.Errorf("ran %q, want %q", calls, want)
+			}
+
+			if strings.Join(lines, "\n") != strings.Join(tt.lines, "\n") {
+				t.Errorf("showed %q, want %q", lines, tt.lines)
+			}
+
+			if tt.exitCode == 0 {
+				if err != nil {
+					t.Errorf("returned %v, want no error", err)
+				}
+				return
+			}
+
+			var runErr *runner.Error
+			if !errors.As(err, &runErr) || runErr.ExitCode != tt.exitCode {
+				t.Fatalf("returned %v, want a runner error with the exit code %d", err, tt.exitCode)
+			}
+			if !strings.Contains(runErr.Error(), "invalid version") {
+				t.Errorf("the error %q doesn't contain the output of go install", runErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,467 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+
+	"github.com/refiber/refiber-cli/cmd/generator"
+	"github.com/refiber/refiber-cli/cmd/runner"
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/ui/selectInput"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade the project to a newer Refiber release",
+	Long: `Upgrade github.com/refiber/framework in go.mod.
+
+The scaffold files of the project are then compared with the scaffold releases requiring the current and the new
+framework release. The files you did not customize are updated, the customized files are merged when the changes
+don't conflict. The module is tidied last, and vendored again when the project is vendored.`,
+	Args: cobra.NoArgs,
+	Run:  upgrade,
+}
+
+func init() {
+	rootCmd.AddCommand(upgradeCmd)
+	upgradeCmd.Flags().String("to", "", "Release to upgrade to, e.g. v0.2.0 (default is the latest release)")
+	upgradeCmd.Flags().String("from", "", "Release the project was created from (default is the framework version of go.mod)")
+	upgradeCmd.Flags().String("scaffold-from", "", "Scaffold release the project was created from (default is the scaffold release requiring --from)")
+	upgradeCmd.Flags().String("scaffold-to", "", "Scaffold release to upgrade the files to (default is the scaffold release requiring --to)")
+	upgradeCmd.Flags().Bool("dry-run", false, "Show the changes without applying them")
+	upgradeCmd.Flags().BoolP("interactive", "i", false, "Ask before updating each scaffold file")
+	upgradeCmd.Flags().Bool("conflicts", false, "Write the conflicting merges with conflict markers instead of skipping them")
+}
+
+// scaffoldIgnoredFiles are not compared with the scaffold, they are updated by the tools owning them
+var scaffoldIgnoredFiles = map[string]bool{
+	"go.mod":            true,
+	"go.sum":            true,
+	".env":              true,
+	"package-lock.json": true,
+	"pnpm-lock.yaml":    true,
+	"yarn.lock":         true,
+	"bun.lockb":         true,
+	"bun.lock":          true,
+}
+
+type scaffoldMerge struct {
+	Conflicts []string // customized files whose changes conflict
+	Removed   []string // files removed from the scaffold
+}
+
+func upgrade(cmd *cobra.Command, args []string) {
+	fmt.Println()
+
+	currentWorkingDir, err := getProjectDir()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	current, err := getFrameworkRequirement(currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	target, _ := cmd.Flags().GetString("to")
+	if target == "" {
+		target, err = runner.Output(context.Background(), &runner.Command{
+			Name:    "go",
+			Args:    []string{"list", "-mod=mod", "-m", "-f", "{{.Version}}", utils.FrameworkModulePath + "@latest"},
+			Dir:     currentWorkingDir,
+			Timeout: time.Minute,
+		})
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render("unable to find the latest release: " + err.Error()))
+		}
+	}
+	if !strings.HasPrefix(target, "v") {
+		target = "v" + target
+	}
+	if !semver.IsValid(target) {
+		cobra.CheckErr(ui.TextError.Render(fmt.Sprintf("invalid release %s, use e.g. v0.2.0", target)))
+	}
+
+	if semver.Compare(current, target) == 0 {
+		fmt.Println(ui.TextGreen.Render(fmt.Sprintf("The project already uses %s %s", utils.FrameworkModulePath, target)))
+		fmt.Println()
+		return
+	}
+	if semver.Compare(current, target) > 0 {
+		fmt.Println(ui.TextWarning.Render(fmt.Sprintf("Downgrading from %s to %s", current, target)))
+	}
+
+	from, _ := cmd.Flags().GetString("from")
+	if from == "" {
+		from = current
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	fmt.Println(ui.TextTitle.Render("Upgrading "+utils.FrameworkModulePath) + " " + ui.TextGray.Render(current+" → "+target))
+	fmt.Println()
+
+	if err := upgradeModule(currentWorkingDir, target, dryRun); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	upgradeScaffold(cmd, currentWorkingDir, from, target, dryRun)
+
+	// the merged scaffold files may import new packages
+	if err := tidyModule(currentWorkingDir, dryRun); err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+	fmt.Println()
+}
+
+// upgradeScaffold merges the changes of the scaffold between the releases requiring the framework versions
+func upgradeScaffold(cmd *cobra.Command, currentWorkingDir, from, target string, dryRun bool) {
+	moduleName, err := utils.GetModuleName(currentWorkingDir)
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	interactive, _ := cmd.Flags().GetBool("interactive")
+	opts := &generator.Options{DryRun: dryRun, Force: !interactive}
+	if interactive {
+		opts.Confirm = confirmUpgrade(target)
+	}
+	tx := generator.NewTransaction(currentWorkingDir, opts)
+
+	writeConflicts, _ := cmd.Flags().GetBool("conflicts")
+
+	fmt.Println()
+
+	scaffoldFrom, scaffoldTo, err := scaffoldReleases(cmd, from, target)
+	if err != nil {
+		fmt.Println(ui.TextWarning.Render("The scaffold files are not upgraded: " + err.Error() + ", use --scaffold-from and --scaffold-to"))
+		fmt.Println()
+		return
+	}
+
+	fmt.Println(ui.TextGray.Render(fmt.Sprintf("comparing the scaffold of %s and %s", scaffoldFrom, scaffoldTo)))
+	fmt.Println()
+
+	tempDir, baseDir, theirsDir, err := downloadScaffolds(scaffoldFrom, scaffoldTo)
+	if err != nil {
+		fmt.Println(ui.TextWarning.Render("The scaffold files are not upgraded: " + err.Error()))
+		fmt.Println()
+		return
+	}
+	defer os.RemoveAll(tempDir)
+
+	merge, err := mergeScaffold(tx, currentWorkingDir, moduleName, baseDir, theirsDir, scaffoldTo, writeConflicts)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			fmt.Println(ui.TextError.Render(rollbackErr.Error()))
+		}
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	printScaffoldMerge(tx, merge, scaffoldTo, writeConflicts)
+}
+
+// scaffoldReleases returns the scaffold releases of the flags, or the releases requiring the framework versions
+func scaffoldReleases(cmd *cobra.Command, from, target string) (string, string, error) {
+	scaffoldFrom, _ := cmd.Flags().GetString("scaffold-from")
+	scaffoldTo, _ := cmd.Flags().GetString("scaffold-to")
+
+	var err error
+	if scaffoldFrom == "" {
+		if scaffoldFrom, err = utils.FindScaffoldRelease(from); err != nil {
+			return "", "", err
+		}
+	}
+	if scaffoldTo == "" {
+		if scaffoldTo, err = utils.FindScaffoldRelease(target); err != nil {
+			return "", "", err
+		}
+	}
+
+	return scaffoldFrom, scaffoldTo, nil
+}
+
+// getFrameworkRequirement returns the version of the framework required by go.mod
+func getFrameworkRequirement(currentWorkingDir string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(currentWorkingDir, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+
+	f, err := modfile.Parse("go.mod", content, nil)
+	if err != nil {
+		return "", err
+	}
+
+	for _, r := range f.Replace {
+		if r.Old.Path == utils.FrameworkModulePath {
+			return "", fmt.Errorf("%s is replaced by %s in go.mod, update the replace directive instead", utils.FrameworkModulePath, r.New.Path)
+		}
+	}
+
+	for _, r := range f.Require {
+		if r.Mod.Path == utils.FrameworkModulePath {
+			return r.Mod.Version, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s is not required in go.mod", utils.FrameworkModulePath)
+}
+
+// upgradeModule requires the release in go.mod
+func upgradeModule(currentWorkingDir, target string, dryRun bool) error {
+	return runUpgradeSteps(currentWorkingDir, dryRun, [][]string{{"go", "get", utils.FrameworkModulePath + "@" + target}})
+}
+
+// tidyModule tidies the module and vendors it again when the project is vendored
+func tidyModule(currentWorkingDir string, dryRun bool) error {
+	steps := [][]string{{"go", "mod", "tidy"}}
+	if utils.DoesDirectoryOrFileExist(filepath.Join(currentWorkingDir, "vendor")) {
+		steps = append(steps, []string{"go", "mod", "vendor"})
+	}
+
+	return runUpgradeSteps(currentWorkingDir, dryRun, steps)
+}
+
+func runUpgradeSteps(currentWorkingDir string, dryRun bool, steps [][]string) error {
+	for _, step := range steps {
+		if dryRun {
+			fmt.Println(ui.TextGray.Render("would run " + strings.Join(step, " ")))
+			continue
+		}
+
+		if _, err := runner.Run(context.Background(), &runner.Command{Name: step[0], Args: step[1:], Dir: currentWorkingDir, Timeout: 5 * time.Minute}); err != nil {
+			return err
+		}
+		fmt.Println(ui.TextGreen.Render("✓") + " " + strings.Join(step, " "))
+	}
+
+	return nil
+}
+
+// downloadScaffolds downloads the scaffold of both releases into a temporary folder, removed by the caller
+func downloadScaffolds(from, to string) (tempDir, baseDir, theirsDir string, err error) {
+	tempDir, err = os.MkdirTemp("", "refiber-upgrade-")
+	if err != nil {
+		return "", "", "", err
+	}
+
+	baseDir, theirsDir = filepath.Join(tempDir, from), filepath.Join(tempDir, to)
+	for _, release := range []struct{ version, dir string }{{from, baseDir}, {to, theirsDir}} {
+		if err := downloadScaffold(release.version, release.dir); err != nil {
+			os.RemoveAll(tempDir)
+			return "", "", "", fmt.Errorf("unable to download the scaffold of %s: %w", release.version, err)
+		}
+	}
+
+	return tempDir, baseDir, theirsDir, nil
+}
+
+// mergeScaffold applies the changes between the scaffold of two releases to the project
+func mergeScaffold(tx *generator.Transaction, currentWorkingDir, moduleName, baseDir, theirsDir, to string, writeConflicts bool) (*scaffoldMerge, error) {
+	// the scaffold uses its own module name, the project was renamed by new
+	readScaffoldFile := func(dir, rel string) ([]byte, bool) {
+		content, err := os.ReadFile(filepath.Join(dir, rel))
+		if err != nil {
+			return nil, false
+		}
+		return bytes.ReplaceAll(content, []byte(utils.ScaffoldModuleName), []byte(moduleName)), true
+	}
+
+	baseFiles, err := listScaffoldFiles(baseDir)
+	if err != nil {
+		return nil, err
+	}
+	theirsFiles, err := listScaffoldFiles(theirsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	merge := &scaffoldMerge{}
+
+	for _, rel := range theirsFiles {
+		theirs, _ := readScaffoldFile(theirsDir, rel)
+		base, inBase := readScaffoldFile(baseDir, rel)
+		if inBase && bytes.Equal(base, theirs) {
+			continue
+		}
+
+		path := filepath.Join(currentWorkingDir, rel)
+		ours, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			// a file removed from the project on purpose is not added back
+			if !inBase {
+				if err := tx.Create(path, theirs); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Equal(ours, theirs) {
+			continue
+		}
+
+		if inBase && bytes.Equal(ours, base) {
+			if err := tx.Create(path, theirs); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if isBinary(ours) || isBinary(theirs) {
+			merge.Conflicts = append(merge.Conflicts, rel)
+			continue
+		}
+
+		merged, conflicts := utils.Merge3(base, ours, theirs, "project", to)
+		if conflicts > 0 {
+			merge.Conflicts = append(merge.Conflicts, rel)
+			if !writeConflicts {
+				continue
+			}
+		}
+
+		if err := tx.Create(path, merged); err != nil {
+			return nil, err
+		}
+	}
+
+	theirsSet := map[string]bool{}
+	for _, rel := range theirsFiles {
+		theirsSet[rel] = true
+	}
+	for _, rel := range baseFiles {
+		if !theirsSet[rel] && utils.DoesDirectoryOrFileExist(filepath.Join(currentWorkingDir, rel)) {
+			merge.Removed = append(merge.Removed, rel)
+		}
+	}
+
+	return merge, nil
+}
+
+// listScaffoldFiles returns the files of an extracted scaffold, relative to its folder
+func listScaffoldFiles(dir string) ([]string, error) {
+	var files []string
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == "vendor" || d.Name() == "node_modules" || d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if !scaffoldIgnoredFiles[filepath.ToSlash(rel)] {
+			files = append(files, rel)
+		}
+
+		return nil
+	})
+
+	sort.Strings(files)
+	return files, err
+}
+
+func isBinary(content []byte) bool {
+	return bytes.IndexByte(content, 0) >= 0
+}
+
+func confirmUpgrade(target string) func(f *generator.File, rel string) (bool, error) {
+	return func(f *generator.File, rel string) (bool, error) {
+		apply, skip, showDiff := "Apply", "Skip", "Show the diff"
+
+		for {
+			var choice string
+			p := tea.NewProgram(selectInput.InitialSelectInputModel(&choice, rel+" changed in "+target, []*string{&apply, &skip, &showDiff}))
+			if _, err := p.Run(); err != nil {
+				return false, err
+			}
+
+			if choice != showDiff {
+				return choice == apply, nil
+			}
+
+			fmt.Print(ui.RenderDiff(utils.UnifiedDiff("a/"+rel, "b/"+rel, f.Original(), f.Content)))
+			fmt.Println()
+		}
+	}
+}
+
+func printScaffoldMerge(tx *generator.Transaction, merge *scaffoldMerge, target string, writeConflicts bool) {
+	if len(tx.Files()) == 0 && len(merge.Conflicts) == 0 && len(merge.Removed) == 0 {
+		fmt.Println(ui.TextGreen.Render("The scaffold files are up to date"))
+		fmt.Println()
+		return
+	}
+
+	if len(tx.Files()) > 0 {
+		if tx.DryRun() {
+			for _, f := range tx.Files() {
+				if f.Action == generator.Skipped {
+					continue
+				}
+				if diff := tx.Diff(f); diff != "" {
+					fmt.Print(diff)
+					fmt.Println()
+				}
+			}
+		}
+
+		fmt.Print(tx.Tree())
+		fmt.Println()
+		fmt.Println(tx.Summary())
+		fmt.Println()
+	}
+
+	if len(merge.Conflicts) > 0 {
+		if writeConflicts {
+			fmt.Println(ui.TextWarning.Render("Resolve the conflict markers in the customized files:"))
+		} else {
+			fmt.Println(ui.TextWarning.Render("The changes of " + target + " conflict with your customizations, these files are not updated:"))
+		}
+		for _, rel := range merge.Conflicts {
+			fmt.Println("  " + rel)
+		}
+		if !writeConflicts {
+			fmt.Println(ui.TextGray.Render("Use --conflicts to write them with conflict markers"))
+		}
+		fmt.Println()
+	}
+
+	if len(merge.Removed) > 0 {
+		fmt.Println(ui.TextWarning.Render("These files are not part of the scaffold of " + target + " anymore, remove them when they are unused:"))
+		for _, rel := range merge.Removed {
+			fmt.Println("  " + rel)
+		}
+		fmt.Println()
+	}
+
+	if tx.DryRun() {
+		fmt.Println(ui.TextWarning.Render("Dry run, no files have been written"))
+		fmt.Println()
+	}
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AppBootstrap is the code of the project building the Fiber app and registering the routes
+type AppBootstrap struct {
+	File        string   // main.go
+	Imports     []string // import specs of the file, e.g. "github.com/gofiber/fiber/v2"
+	Statements  string   // the statements up to the routes registration
+	AppVariable string   // app
+}
+
+// FindAppBootstrap finds the function calling the routes package, in the main package
+// at the root of the project or in the cmd folder, and the variable of the Fiber app
+func FindAppBootstrap(projectPath string) (*AppBootstrap, error) {
+	moduleName, err := GetModuleName(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	routesImportPath := moduleName + "/routes"
+
+	dirs := []string{projectPath}
+	if entries, err := os.ReadDir(filepath.Join(projectPath, "cmd")); err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() {
+				dirs = append(dirs, filepath.Join(projectPath, "cmd", entry.Name()))
+			}
+		}
+	}
+
+	fset := token.NewFileSet()
+	for _, dir := range dirs {
+		files, err := parseGoFiles(fset, dir)
+		if err != nil {
+			continue
+		}
+
+		for _, file := range files {
+			if file.Name.Name != "main" {
+				continue
+			}
+
+			imports := fileImports(file)
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Body == nil {
+					continue
+				}
+
+				if b := bootstrapOf(fset, fn, imports, routesImportPath); b != nil {
+					b.File, _ = filepath.Rel(projectPath, fset.Position(file.Pos()).Filename)
+					for _, spec := range file.Imports {
+						b.Imports = append(b.Imports, nodeString(fset, spec))
+					}
+					return b, nil
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find where the routes are registered in the main package")
+}
+
+func bootstrapOf(fset *token.FileSet, fn *ast.FuncDecl, imports map[string]string, routesImportPath string) *AppBootstrap {
+	// the statement calling the routes package, e.g. routes.SetupRouter(r, s)
+	last := -1
+	for i, stmt := range fn.Body.List {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if ident, ok := sel.X.(*ast.Ident); ok && imports[ident.Name] == routesImportPath {
+					last = i
+				}
+			}
+			return last < 0
+		})
+		if last >= 0 {
+			break
+		}
+	}
+	if last < 0 {
+		return nil
+	}
+
+	// the Fiber app is the receiver of Listen or the result of fiber.New
+	var app string
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && strings.HasPrefix(sel.Sel.Name, "Listen") {
+				if ident, ok := sel.X.(*ast.Ident); ok {
+					app = ident.Name
+				}
+			}
+		case *ast.AssignStmt:
+			if len(n.Rhs) != 1 || app != "" {
+				break
+			}
+			if call, ok := n.Rhs[0].(*ast.CallExpr); ok {
+				if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "New" {
+					if ident, ok := sel.X.(*ast.Ident); ok && imports[ident.Name] == "github.com/gofiber/fiber/v2" {
+						if lhs, ok := n.Lhs[0].(*ast.Ident); ok {
+							app = lhs.Name
+						}
+					}
+				}
+			}
+		}
+		return true
+	})
+	if app == "" {
+		return nil
+	}
+
+	var statements []string
+	for _, stmt := range fn.Body.List[:last+1] {
+		// deferred calls would run when the app is returned
+		if _, ok := stmt.(*ast.DeferStmt); ok {
+			continue
+		}
+		statements = append(statements, nodeString(fset, stmt))
+	}
+
+	return &AppBootstrap{
+		Statements:  strings.Join(statements, "\n"),
+		AppVariable: app,
+	}
+}
+
+func nodeString(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, node); err != nil {
+		return ""
+	}
+	return buf.String()
+}
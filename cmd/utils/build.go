@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/refiber/refiber-cli/cmd/runner"
+)
+
+// manifestCandidates are the usual places of the Vite manifest, relative to the project
+var manifestCandidates = []string{
+	filepath.Join("public", "build", "manifest.json"),
+	filepath.Join("public", "build", ".vite", "manifest.json"),
+	filepath.Join("public", "dist", "manifest.json"),
+	filepath.Join("public", "dist", ".vite", "manifest.json"),
+	filepath.Join("dist", ".vite", "manifest.json"),
+	filepath.Join("dist", "manifest.json"),
+}
+
+// FindViteManifest returns the path of the manifest written by vite build
+func FindViteManifest(projectPath string) (string, error) {
+	for _, candidate := range manifestCandidates {
+		p := filepath.Join(projectPath, candidate)
+		if DoesDirectoryOrFileExist(p) {
+			return p, nil
+		}
+	}
+
+	return "", fmt.Errorf("the Vite manifest was not found in %s, make sure build.manifest is enabled in vite.config", strings.Join(manifestCandidates, ", "))
+}
+
+// GitVersion returns the version and the commit of the project from git,
+// e.g. v1.2.0-3-gabc1234-dirty and abc1234, both are empty outside of a git repository
+func GitVersion(projectPath string) (version, commit string) {
+	git := func(args ...string) string {
+		out, err := runner.Output(context.Background(), &runner.Command{Name: "git", Args: args, Dir: projectPath})
+		if err != nil {
+			return ""
+		}
+		return out
+	}
+
+	return git("describe", "--tags", "--always", "--dirty"), git("rev-parse", "--short", "HEAD")
+}
+
+// FileSHA256 returns the hex encoded SHA-256 sum of a file
+func FileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// FormatBytes formats a size for humans, e.g. 12.3 MB
+func FormatBytes(size int64) string {
+	const unit = 1000
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "kMGTPE"[exp])
+}
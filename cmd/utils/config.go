@@ -0,0 +1,536 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ConfigModulePrefix       = "module.prefix"
+	ConfigPackageManager     = "frontend.package_manager"
+	ConfigControllerReceiver = "controller.receiver"
+	ConfigTemplateSources    = "templates.sources"
+	ConfigTheme              = "ui.theme"
+	ConfigUpdateCheck        = "update_check.enabled"
+)
+
+// the layers of the configuration, a layer overrides the previous ones
+const (
+	ConfigLayerDefault = "default"
+	ConfigLayerUser    = "user"
+	ConfigLayerProject = "project"
+	ConfigLayerEnv     = "env"
+	ConfigLayerFlag    = "flag"
+)
+
+// ProjectConfigFileName is the configuration file in the root of a project
+const ProjectConfigFileName = "refiber.yaml"
+
+// ConfigEnvPrefix prefixes the environment variables of the settings, e.g. REFIBER_UI_THEME
+const ConfigEnvPrefix = "REFIBER_"
+
+// ConfigKey is a setting of refiber-cli
+type ConfigKey struct {
+	Name        string // ui.theme
+	Description string
+	Default     string
+	Values      []string // allowed values, any value when empty
+	Flag        string   // flag of the commands overriding the setting
+	Paths       bool     // a list of folders, separated by os.PathListSeparator in env and flags
+	Validate    func(value string) error
+}
+
+var modulePrefixRegex = regexp.MustCompile(`^([a-zA-Z0-9_\-\.]+\/)*[a-zA-Z0-9_\-\.]+$`)
+
+// ConfigKeys are the settings of refiber-cli
+var ConfigKeys = []*ConfigKey{
+	{
+		Name:        ConfigModulePrefix,
+		Description: "Prefix of the module name of new projects, e.g. github.com/kevin",
+		Flag:        "module-prefix",
+		Validate: func(value string) error {
+			if value != "" && !modulePrefixRegex.MatchString(value) {
+				return fmt.Errorf("invalid module prefix %s", value)
+			}
+			return nil
+		},
+	},
+	{
+		Name:        ConfigPackageManager,
+		Description: "Package manager of the frontend when the project has no lock file",
+		Default:     "npm",
+		Values:      []string{"npm", "pnpm", "yarn", "bun"},
+		Flag:        "package-manager",
+	},
+	{
+		Name:        ConfigControllerReceiver,
+		Description: "Receiver name of the generated controllers",
+		Default:     "ctr",
+		Flag:        "receiver",
+		Validate: func(value string) error {
+			if !token.IsIdentifier(value) || token.IsKeyword(value) {
+				return fmt.Errorf("invalid receiver name %s", value)
+			}
+			return nil
+		},
+	},
+	{
+		Name:        ConfigTemplateSources,
+		Description: "Folders of templates looked up after the project stubs, before the global stubs",
+		Flag:        "templates",
+		Paths:       true,
+	},
+	{
+		Name:        ConfigTheme,
+		Description: "Color theme of the output",
+		Default:     "default",
+		Values:      []string{"default", "light", "monochrome"},
+		Flag:        "theme",
+	},
+	{
+		Name:        ConfigUpdateCheck,
+		Description: "Check once a day whether refiber-cli or the framework is outdated",
+		Default:     "true",
+		Values:      []string{"true", "false"},
+	},
+}
+
+// GetConfigKey returns the setting of a name, e.g. ui.theme
+func GetConfigKey(name string) (*ConfigKey, error) {
+	for _, key := range ConfigKeys {
+		if key.Name == name {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown setting %s, see refiber-cli config:list", name)
+}
+
+// EnvName returns the environment variable of the setting, e.g. REFIBER_UI_THEME
+func (k *ConfigKey) EnvName() string {
+	return ConfigEnvPrefix + strings.ToUpper(strings.ReplaceAll(k.Name, ".", "_"))
+}
+
+// Check returns an error when the value is not allowed
+func (k *ConfigKey) Check(value string) error {
+	if len(k.Values) > 0 && !contains(k.Values, value) {
+		return fmt.Errorf("invalid value %s for %s, use one of %s", value, k.Name, strings.Join(k.Values, ", "))
+	}
+
+	if k.Validate != nil {
+		return k.Validate(value)
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConfigValue is the value of a setting and the layer it comes from
+type ConfigValue struct {
+	Key    *ConfigKey
+	Value  string
+	Layer  string
+	Source string // file, environment variable or flag of the value
+}
+
+// Config is the configuration resolved from the defaults, the user and project configuration files,
+// the environment variables and the flags
+type Config struct {
+	values   map[string]*ConfigValue
+	warnings []string
+}
+
+// ConfigFlags looks up the flags overriding the settings
+type ConfigFlags interface {
+	// ConfigFlag returns the value of a flag passed on the command line
+	ConfigFlag(name string) (value string, ok bool)
+}
+
+var currentConfig *Config
+
+// GetConfig returns the configuration of the command, the defaults until it is loaded
+func GetConfig() *Config {
+	if currentConfig == nil {
+		currentConfig = newConfig()
+	}
+
+	return currentConfig
+}
+
+// SetConfig sets the configuration returned by GetConfig
+func SetConfig(c *Config) {
+	currentConfig = c
+}
+
+func newConfig() *Config {
+	c := &Config{values: map[string]*ConfigValue{}}
+	for _, key := range ConfigKeys {
+		c.values[key.Name] = &ConfigValue{Key: key, Value: key.Default, Layer: ConfigLayerDefault}
+	}
+
+	return c
+}
+
+// GetConfigDirPath returns the folder of the refiber-cli settings in the user config folder
+func GetConfigDirPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "refiber"), nil
+}
+
+// GetUserConfigPath returns the configuration file of the user, REFIBER_CONFIG overrides
+// the default file in the user config folder
+func GetUserConfigPath() (string, error) {
+	if p := os.Getenv("REFIBER_CONFIG"); p != "" {
+		return p, nil
+	}
+
+	dir, err := GetConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "config.yaml"), nil
+}
+
+// LoadConfig resolves the configuration. userConfigPath is the file of the --config flag or
+// the default user configuration, projectDir is empty outside of a project.
+// The unknown or invalid settings of the files and environment variables are skipped with a warning,
+// an invalid flag returns an error along with the configuration without the flag
+func LoadConfig(userConfigPath, projectDir string, flags ConfigFlags) (*Config, error) {
+	c := newConfig()
+
+	c.loadFile(ConfigLayerUser, userConfigPath)
+	if projectDir != "" {
+		c.loadFile(ConfigLayerProject, filepath.Join(projectDir, ProjectConfigFileName))
+	}
+
+	for _, key := range ConfigKeys {
+		if value, ok := os.LookupEnv(key.EnvName()); ok && value != "" {
+			if err := c.set(key, value, ConfigLayerEnv, key.EnvName(), ""); err != nil {
+				c.warn(fmt.Errorf("%s: %w", key.EnvName(), err))
+			}
+		}
+	}
+
+	var flagErr error
+	if flags != nil {
+		for _, key := range ConfigKeys {
+			if key.Flag == "" {
+				continue
+			}
+			if value, ok := flags.ConfigFlag(key.Flag); ok {
+				if err := c.set(key, value, ConfigLayerFlag, "--"+key.Flag, ""); err != nil && flagErr == nil {
+					flagErr = fmt.Errorf("--%s: %w", key.Flag, err)
+				}
+			}
+		}
+	}
+
+	return c, flagErr
+}
+
+// Warnings returns the settings skipped while loading the configuration
+func (c *Config) Warnings() []string {
+	return c.warnings
+}
+
+func (c *Config) warn(err error) {
+	c.warnings = append(c.warnings, err.Error()+", skipping it")
+}
+
+func (c *Config) loadFile(layer, path string) {
+	if path == "" {
+		return
+	}
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		c.warn(err)
+		return
+	}
+
+	var settings map[string]interface{}
+	if err := yaml.Unmarshal(content, &settings); err != nil {
+		c.warn(fmt.Errorf("unable to read %s: %w", path, err))
+		return
+	}
+
+	values := map[string]interface{}{}
+	flattenSettings("", settings, values)
+
+	// sorted to warn in the same order every time
+	var names []string
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		key, err := GetConfigKey(name)
+		if err != nil {
+			c.warn(fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+
+		s, err := settingString(key, values[name])
+		if err != nil {
+			c.warn(fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+
+		if err := c.set(key, s, layer, path, filepath.Dir(path)); err != nil {
+			c.warn(fmt.Errorf("%s: %w", path, err))
+		}
+	}
+}
+
+// flattenSettings converts the nested settings of a file to their names, e.g. ui.theme
+func flattenSettings(prefix string, settings map[string]interface{}, values map[string]interface{}) {
+	for name, value := range settings {
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			flattenSettings(name, nested, values)
+			continue
+		}
+
+		values[name] = value
+	}
+}
+
+// settingString converts a value of a file to the string of the setting
+func settingString(key *ConfigKey, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case []interface{}:
+		if !key.Paths {
+			return "", fmt.Errorf("%s is not a list", key.Name)
+		}
+
+		var paths []string
+		for _, item := range v {
+			paths = append(paths, fmt.Sprint(item))
+		}
+		return strings.Join(paths, string(os.PathListSeparator)), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+func (c *Config) set(key *ConfigKey, value, layer, source, baseDir string) error {
+	if key.Paths {
+		// relative folders of a file are relative to the file
+		var paths []string
+		for _, p := range filepath.SplitList(value) {
+			paths = append(paths, resolveConfigPath(p, baseDir))
+		}
+		value = strings.Join(paths, string(os.PathListSeparator))
+	} else if err := key.Check(value); err != nil {
+		return err
+	}
+
+	c.values[key.Name] = &ConfigValue{Key: key, Value: value, Layer: layer, Source: source}
+	return nil
+}
+
+func resolveConfigPath(p, baseDir string) string {
+	if p == "~" || strings.HasPrefix(p, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			p = filepath.Join(home, strings.TrimPrefix(p, "~"))
+		}
+	}
+
+	if !filepath.IsAbs(p) && baseDir != "" {
+		p = filepath.Join(baseDir, p)
+	}
+
+	return p
+}
+
+// Lookup returns the value of a setting and its layer
+func (c *Config) Lookup(name string) *ConfigValue {
+	return c.values[name]
+}
+
+// Get returns the value of a setting
+func (c *Config) Get(name string) string {
+	if v, ok := c.values[name]; ok {
+		return v.Value
+	}
+
+	return ""
+}
+
+// GetBool returns the value of a true or false setting
+func (c *Config) GetBool(name string) bool {
+	b, _ := strconv.ParseBool(c.Get(name))
+	return b
+}
+
+// GetPaths returns the folders of a list setting
+func (c *Config) GetPaths(name string) []string {
+	var paths []string
+	for _, p := range filepath.SplitList(c.Get(name)) {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+
+	return paths
+}
+
+// Values returns the values of every setting, sorted by name
+func (c *Config) Values() []*ConfigValue {
+	var values []*ConfigValue
+	for _, v := range c.values {
+		values = append(values, v)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Key.Name < values[j].Key.Name
+	})
+
+	return values
+}
+
+// WriteConfigValue sets a setting in a configuration file, keeping its comments.
+// An empty value removes the setting
+func WriteConfigValue(path string, key *ConfigKey, value string) error {
+	if value != "" && !key.Paths {
+		if err := key.Check(value); err != nil {
+			return err
+		}
+	}
+
+	var doc yaml.Node
+	content, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if len(bytes.TrimSpace(content)) > 0 {
+		if err := yaml.Unmarshal(content, &doc); err != nil {
+			return fmt.Errorf("unable to read %s: %w", path, err)
+		}
+	}
+
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("unable to update %s, the settings are not a mapping", path)
+	}
+
+	parts := strings.Split(key.Name, ".")
+	if value == "" {
+		removeSetting(root, parts)
+	} else {
+		setSetting(root, parts, configValueNode(key, value))
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+func configValueNode(key *ConfigKey, value string) *yaml.Node {
+	if key.Paths {
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, p := range filepath.SplitList(value) {
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: p})
+		}
+		return node
+	}
+
+	return &yaml.Node{Kind: yaml.ScalarNode, Value: value}
+}
+
+// setSetting sets the value of the nested keys, e.g. [ui theme], creating the missing mappings
+func setSetting(mapping *yaml.Node, parts []string, value *yaml.Node) {
+	for i := 0; i < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != parts[0] {
+			continue
+		}
+
+		if len(parts) == 1 {
+			mapping.Content[i+1] = value
+			return
+		}
+		if mapping.Content[i+1].Kind != yaml.MappingNode {
+			mapping.Content[i+1] = &yaml.Node{Kind: yaml.MappingNode}
+		}
+		setSetting(mapping.Content[i+1], parts[1:], value)
+		return
+	}
+
+	keyNode := &yaml.Node{Kind: yaml.ScalarNode, Value: parts[0]}
+	if len(parts) == 1 {
+		mapping.Content = append(mapping.Content, keyNode, value)
+		return
+	}
+
+	nested := &yaml.Node{Kind: yaml.MappingNode}
+	mapping.Content = append(mapping.Content, keyNode, nested)
+	setSetting(nested, parts[1:], value)
+}
+
+// removeSetting removes the value of the nested keys and the mappings left empty
+func removeSetting(mapping *yaml.Node, parts []string) {
+	for i := 0; i < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != parts[0] {
+			continue
+		}
+
+		if len(parts) > 1 && mapping.Content[i+1].Kind == yaml.MappingNode {
+			removeSetting(mapping.Content[i+1], parts[1:])
+			if len(mapping.Content[i+1].Content) > 0 {
+				return
+			}
+		} else if len(parts) > 1 {
+			return
+		}
+
+		mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+		return
+	}
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ConsoleCommand is a command of the app/console package
+type ConsoleCommand struct {
+	Name        string `json:"name"`        // reindex:products
+	Description string `json:"description"` // Reindex the products
+	TypeName    string `json:"type"`        // ReindexProducts
+	Location    string `json:"location"`    // app/console/ReindexProducts.go:12
+}
+
+// GetConsoleDirPath returns the folder of the console commands
+func GetConsoleDirPath(projectPath string) string {
+	return filepath.Join(projectPath, "app", "console")
+}
+
+// CommandName returns the name of a command type, e.g. ReindexProducts -> reindex:products
+func CommandName(typeName string) string {
+	words := SplitWords(strings.TrimSuffix(typeName, "Command"))
+	if len(words) == 0 {
+		return ""
+	}
+
+	name := strings.ToLower(words[0])
+	if len(words) > 1 {
+		name += ":" + ToKebabCase(strings.Join(words[1:], ""))
+	}
+
+	return name
+}
+
+// ParseConsoleCommands finds the types of app/console with a Name method returning a string literal
+func ParseConsoleCommands(projectPath string) ([]*ConsoleCommand, error) {
+	consoleDirPath := GetConsoleDirPath(projectPath)
+	if !DoesDirectoryOrFileExist(consoleDirPath) {
+		return nil, nil
+	}
+
+	fset := token.NewFileSet()
+	files, err := parseGoFiles(fset, consoleDirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	commands := map[string]*ConsoleCommand{}
+	descriptions := map[string]string{}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Body == nil {
+				continue
+			}
+
+			value, ok := returnedString(fn)
+			if !ok {
+				continue
+			}
+
+			typeName := baseTypeName(fn.Recv.List[0].Type)
+			switch fn.Name.Name {
+			case "Name":
+				position := fset.Position(fn.Pos())
+				rel, err := filepath.Rel(projectPath, position.Filename)
+				if err != nil {
+					rel = position.Filename
+				}
+
+				commands[typeName] = &ConsoleCommand{
+					Name:     value,
+					TypeName: typeName,
+					Location: fmt.Sprintf("%s:%d", filepath.ToSlash(rel), position.Line),
+				}
+			case "Description":
+				descriptions[typeName] = value
+			}
+		}
+	}
+
+	var list []*ConsoleCommand
+	for typeName, command := range commands {
+		command.Description = descriptions[typeName]
+		list = append(list, command)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+
+	return list, nil
+}
+
+// returnedString returns the string literal of a function made of a single return statement
+func returnedString(fn *ast.FuncDecl) (string, bool) {
+	if len(fn.Body.List) != 1 {
+		return "", false
+	}
+
+	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", false
+	}
+
+	lit, ok := ret.Results[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+
+	value, err := strconv.Unquote(lit.Value)
+	return value, err == nil
+}
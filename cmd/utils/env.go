@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// ReadEnvKeys returns the keys of a .env file in order, skipping the comments and the blank lines
+func ReadEnvKeys(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var keys []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, _, found := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		if key = strings.TrimSpace(key); found && key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, scanner.Err()
+}
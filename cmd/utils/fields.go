@@ -0,0 +1,190 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type Field struct {
+	Name     string // ProductName
+	JSONName string // product_name
+	Type     string // string, as provided by the user
+	GoType   string // string
+	Optional bool
+}
+
+// fieldTypes maps the field types accepted by --fields to Go types
+var fieldTypes = map[string]string{
+	"string":   "string",
+	"text":     "string",
+	"email":    "string",
+	"url":      "string",
+	"uuid":     "string",
+	"int":      "int",
+	"integer":  "int",
+	"int64":    "int64",
+	"uint":     "uint",
+	"float":    "float64",
+	"float64":  "float64",
+	"decimal":  "float64",
+	"bool":     "bool",
+	"boolean":  "bool",
+	"date":     "time.Time",
+	"datetime": "time.Time",
+	"time":     "time.Time",
+	"json":     "json.RawMessage",
+}
+
+var fieldNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
+// ParseFields parses a field definition like "name:string price:int description:text?",
+// a trailing ? marks the field as optional
+func ParseFields(definition string) ([]*Field, error) {
+	var fields []*Field
+
+	for _, def := range strings.FieldsFunc(definition, func(r rune) bool { return r == ' ' || r == ',' }) {
+		name, fieldType, found := strings.Cut(def, ":")
+		if !found {
+			fieldType = "string"
+		}
+
+		optional := strings.HasSuffix(fieldType, "?") || strings.HasSuffix(name, "?")
+		name = strings.TrimSuffix(name, "?")
+		fieldType = strings.ToLower(strings.TrimSuffix(fieldType, "?"))
+
+		if !fieldNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("invalid field name %q", name)
+		}
+
+		goType, ok := fieldTypes[fieldType]
+		if !ok {
+			return nil, fmt.Errorf("unsupported type %q for field %s", fieldType, name)
+		}
+
+		fields = append(fields, &Field{
+			Name:     ToPascalCase(name),
+			JSONName: ToSnakeCase(name),
+			Type:     fieldType,
+			GoType:   goType,
+			Optional: optional,
+		})
+	}
+
+	return fields, nil
+}
+
+// ValidationRules returns the go-playground/validator rules of the field
+func (f *Field) ValidationRules() string {
+	var rules []string
+
+	if f.Optional {
+		rules = append(rules, "omitempty")
+	} else if f.GoType != "bool" {
+		rules = append(rules, "required")
+	}
+
+	switch f.Type {
+	case "email":
+		rules = append(rules, "email")
+	case "url":
+		rules = append(rules, "url")
+	case "uuid":
+		rules = append(rules, "uuid")
+	case "string":
+		rules = append(rules, "max=255")
+	case "date":
+		rules = append(rules, "datetime=2006-01-02")
+	}
+
+	return strings.Join(rules, ",")
+}
+
+// RequestGoType returns the type used for the field in a request struct,
+// dates are received as strings from forms
+func (f *Field) RequestGoType() string {
+	switch f.GoType {
+	case "time.Time":
+		return "string"
+	case "json.RawMessage":
+		return "map[string]any"
+	}
+
+	return f.GoType
+}
+
+// Label returns the human readable name of the field, e.g. Product name
+func (f *Field) Label() string {
+	words := SplitWords(f.Name)
+	for i := 1; i < len(words); i++ {
+		words[i] = strings.ToLower(words[i])
+	}
+	return strings.Join(words, " ")
+}
+
+// InputType returns the type of the HTML input of the field
+func (f *Field) InputType() string {
+	switch f.Type {
+	case "email", "url", "date":
+		return f.Type
+	case "datetime", "time":
+		return "datetime-local"
+	case "bool", "boolean":
+		return "checkbox"
+	}
+
+	switch f.GoType {
+	case "int", "int64", "uint", "float64":
+		return "number"
+	}
+
+	return "text"
+}
+
+// JSDefault returns the initial value of the field in a frontend form
+func (f *Field) JSDefault() string {
+	switch f.InputType() {
+	case "checkbox":
+		return "false"
+	case "number":
+		return "0"
+	}
+
+	return "''"
+}
+
+// SQLType returns the column type of the field for the database driver (mysql, postgres or sqlite)
+func (f *Field) SQLType(driver string) string {
+	switch f.Type {
+	case "string", "email", "url":
+		return "VARCHAR(255)"
+	case "uuid":
+		return "VARCHAR(36)"
+	case "text":
+		return "TEXT"
+	case "int", "integer":
+		return "INTEGER"
+	case "int64", "uint":
+		return "BIGINT"
+	case "decimal":
+		return "DECIMAL(10, 2)"
+	case "float", "float64":
+		if driver == "postgres" {
+			return "DOUBLE PRECISION"
+		}
+		return "DOUBLE"
+	case "bool", "boolean":
+		return "BOOLEAN"
+	case "date":
+		return "DATE"
+	case "datetime", "time":
+		return "TIMESTAMP"
+	case "json":
+		if driver == "postgres" {
+			return "JSONB"
+		}
+		return "JSON"
+	}
+
+	return "TEXT"
+}
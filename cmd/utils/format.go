@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/imports"
+)
+
+// FormatGoSource formats generated Go source like gofmt and goimports,
+// the unused imports are removed and the missing ones are added.
+// A source that doesn't parse returns a scanner.ErrorList
+//
+// The imports package runs the go command in the working directory of the process and has
+// no option to change it, so FormatGoSource changes the process working directory to the
+// module of the file until it returns. The calls are serialized by importsMu, but nothing
+// else may resolve relative paths concurrently: the callers must use absolute paths
+// while files are formatted, as the generators do
+func FormatGoSource(filename string, src []byte) ([]byte, error) {
+	if _, err := parser.ParseFile(token.NewFileSet(), filename, src, parser.AllErrors); err != nil {
+		return nil, err
+	}
+
+	// the working directory must be the module of the file to resolve the imports
+	// of the project when the CLI runs from another folder, e.g. with --project
+	importsMu.Lock()
+	defer importsMu.Unlock()
+
+	if root := findModuleRoot(filepath.Dir(filename)); root != "" {
+		if wd, err := os.Getwd(); err == nil && wd != root && os.Chdir(root) == nil {
+			defer os.Chdir(wd)
+		}
+	}
+
+	return imports.Process(filename, src, &imports.Options{
+		Comments:   true,
+		TabIndent:  true,
+		TabWidth:   8,
+		FormatOnly: false,
+	})
+}
+
+// importsMu serializes the changes of the working directory made by FormatGoSource
+var importsMu sync.Mutex
+
+// findModuleRoot returns the nearest folder containing a go.mod, the folder may not exist yet
+func findModuleRoot(dir string) string {
+	for d := dir; ; d = filepath.Dir(d) {
+		if DoesDirectoryOrFileExist(filepath.Join(d, "go.mod")) {
+			return d
+		}
+		if d == filepath.Dir(d) {
+			return ""
+		}
+	}
+}
+
+// SyntaxErrors returns the syntax errors of a FormatGoSource error
+func SyntaxErrors(err error) (scanner.ErrorList, bool) {
+	list, ok := err.(scanner.ErrorList)
+	return list, ok
+}
+
+var templateActionRegex = regexp.MustCompile(`{{.*?}}`)
+
+// FindTemplateLine returns the line of the template that most likely rendered the
+// line of the output, matching the text around the template actions, or 0
+func FindTemplateLine(tmpl []byte, rendered string) int {
+	rendered = strings.TrimSpace(rendered)
+	if rendered == "" {
+		return 0
+	}
+
+	bestLine, bestScore := 0, 0
+	for i, line := range strings.Split(string(tmpl), "\n") {
+		line = strings.TrimSpace(line)
+
+		var pattern strings.Builder
+		score := 0
+		for j, part := range templateActionRegex.Split(line, -1) {
+			if j > 0 {
+				pattern.WriteString(".*")
+			}
+			pattern.WriteString(regexp.QuoteMeta(part))
+			score += len(part)
+		}
+
+		if score <= bestScore {
+			continue
+		}
+
+		if matched, _ := regexp.MatchString("^"+pattern.String()+"$", rendered); matched {
+			bestLine, bestScore = i+1, score
+		}
+	}
+
+	return bestLine
+}
@@ -0,0 +1,273 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/build"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+
+	"github.com/refiber/refiber-cli/cmd/runner"
+)
+
+// FrameworkModule is the framework module used by a project
+type FrameworkModule struct {
+	Path    string // github.com/refiber/framework, or the module path of the fork replacing it
+	Version string
+	Dir     string // empty when the module is not downloaded
+	Local   bool   // replaced by a local folder or used by the go.work
+}
+
+type goListModule struct {
+	Path    string
+	Version string
+	Dir     string
+	Replace *goListModule
+}
+
+// resolved framework modules by project folder, generators resolve the templates many times
+var frameworkModules = map[string]*FrameworkModule{}
+
+// GetFrameworkModule resolves the folder of the framework module of the project with
+// go list, honoring the replace directives, go.work, GOMODCACHE and GOPATH. When go is not
+// available the go.mod and go.work files are read instead. A missing module is downloaded
+func GetFrameworkModule(projectPath string) (*FrameworkModule, error) {
+	if m, ok := frameworkModules[projectPath]; ok {
+		return m, nil
+	}
+
+	m, err := goListFrameworkModule(projectPath)
+	if err != nil {
+		if m, err = readFrameworkModule(projectPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if !m.Local && !DoesDirectoryOrFileExist(filepath.Join(m.Dir, "templates")) {
+		if dir, err := downloadFrameworkModule(projectPath, m.Path, m.Version); err == nil {
+			m.Dir = dir
+		}
+	}
+
+	frameworkModules[projectPath] = m
+	return m, nil
+}
+
+// goListFrameworkModule asks go list for the framework module, without updating go.mod and go.sum
+func goListFrameworkModule(projectPath string) (*FrameworkModule, error) {
+	result, err := runner.Run(context.Background(), &runner.Command{
+		Name: "go",
+		Args: []string{"list", "-mod=readonly", "-m", "-json", FrameworkModulePath},
+		Dir:  projectPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var info goListModule
+	if err := json.Unmarshal(result.Stdout, &info); err != nil {
+		return nil, err
+	}
+
+	m := &FrameworkModule{Path: FrameworkModulePath, Version: info.Version, Dir: info.Dir}
+	if info.Version == "" {
+		// a module of the go.work
+		m.Local = true
+	}
+
+	if r := info.Replace; r != nil {
+		m.Dir = r.Dir
+		if r.Version == "" {
+			m.Local = true
+			if !filepath.IsAbs(m.Dir) {
+				m.Dir = filepath.Join(projectPath, r.Path)
+			}
+		} else {
+			m.Path = r.Path
+			m.Version = r.Version
+			if m.Dir == "" {
+				m.Dir = moduleCacheDir(r.Path, r.Version)
+			}
+		}
+	}
+
+	if m.Dir == "" {
+		m.Dir = moduleCacheDir(FrameworkModulePath, m.Version)
+	}
+
+	return m, nil
+}
+
+// readFrameworkModule resolves the framework module from the go.mod and go.work files
+func readFrameworkModule(projectPath string) (*FrameworkModule, error) {
+	goModFilePath := filepath.Join(projectPath, "go.mod")
+	content, err := os.ReadFile(goModFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("the current folder path is not inside the Refiber project")
+	}
+
+	f, err := modfile.Parse(goModFilePath, content, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var version string
+	for _, r := range f.Require {
+		if r.Mod.Path == FrameworkModulePath {
+			version = r.Mod.Version
+		}
+	}
+	if version == "" {
+		return nil, fmt.Errorf("the current folder path is not inside the Refiber project")
+	}
+
+	if dir := findWorkspaceModule(projectPath, FrameworkModulePath); dir != "" {
+		return &FrameworkModule{Path: FrameworkModulePath, Version: version, Dir: dir, Local: true}, nil
+	}
+
+	for _, r := range f.Replace {
+		if r.Old.Path != FrameworkModulePath || (r.Old.Version != "" && r.Old.Version != version) {
+			continue
+		}
+
+		if r.New.Version == "" {
+			dir := r.New.Path
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(projectPath, dir)
+			}
+			return &FrameworkModule{Path: FrameworkModulePath, Version: version, Dir: dir, Local: true}, nil
+		}
+
+		return &FrameworkModule{Path: r.New.Path, Version: r.New.Version, Dir: moduleCacheDir(r.New.Path, r.New.Version)}, nil
+	}
+
+	return &FrameworkModule{Path: FrameworkModulePath, Version: version, Dir: moduleCacheDir(FrameworkModulePath, version)}, nil
+}
+
+// findWorkspaceModule returns the folder of a module used by the go.work of the project
+func findWorkspaceModule(projectPath, modulePath string) string {
+	workFilePath := os.Getenv("GOWORK")
+	if workFilePath == "off" {
+		return ""
+	}
+
+	if workFilePath == "" {
+		for d := projectPath; ; d = filepath.Dir(d) {
+			if p := filepath.Join(d, "go.work"); DoesDirectoryOrFileExist(p) {
+				workFilePath = p
+				break
+			}
+			if d == filepath.Dir(d) {
+				return ""
+			}
+		}
+	}
+
+	content, err := os.ReadFile(workFilePath)
+	if err != nil {
+		return ""
+	}
+
+	work, err := modfile.ParseWork(workFilePath, content, nil)
+	if err != nil {
+		return ""
+	}
+
+	for _, use := range work.Use {
+		dir := filepath.FromSlash(use.Path)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(filepath.Dir(workFilePath), dir)
+		}
+
+		goMod, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+		if err == nil && modfile.ModulePath(goMod) == modulePath {
+			return dir
+		}
+	}
+
+	return ""
+}
+
+// GetModuleCacheDirs returns the module cache folders, GOMODCACHE or pkg/mod of every GOPATH entry
+func GetModuleCacheDirs() []string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return []string{dir}
+	}
+
+	if dir, err := runner.Output(context.Background(), &runner.Command{Name: "go", Args: []string{"env", "GOMODCACHE"}}); err == nil && dir != "" {
+		return []string{dir}
+	}
+
+	gopath := os.Getenv("GOPATH")
+	if gopath == "" {
+		gopath = build.Default.GOPATH
+	}
+
+	var dirs []string
+	for _, p := range filepath.SplitList(gopath) {
+		if p != "" {
+			dirs = append(dirs, filepath.Join(p, "pkg", "mod"))
+		}
+	}
+
+	return dirs
+}
+
+// moduleCacheDir returns the folder of a module version in the first module cache containing it
+func moduleCacheDir(modulePath, version string) string {
+	escapedPath, err := module.EscapePath(modulePath)
+	if err != nil {
+		return ""
+	}
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return ""
+	}
+
+	dirs := GetModuleCacheDirs()
+	for _, dir := range dirs {
+		p := filepath.Join(dir, filepath.FromSlash(escapedPath)+"@"+escapedVersion)
+		if DoesDirectoryOrFileExist(p) {
+			return p
+		}
+	}
+
+	if len(dirs) == 0 {
+		return ""
+	}
+
+	return filepath.Join(dirs[0], filepath.FromSlash(escapedPath)+"@"+escapedVersion)
+}
+
+// downloadFrameworkModule downloads the framework, or the fork replacing it, to the module cache
+// and returns its folder
+func downloadFrameworkModule(projectPath, modulePath, version string) (string, error) {
+	target := modulePath
+	if version != "" {
+		target += "@" + version
+	}
+
+	result, err := runner.Run(context.Background(), &runner.Command{
+		Name: "go",
+		Args: []string{"mod", "download", "-json", target},
+		Dir:  projectPath,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to download %s: %w", target, err)
+	}
+
+	var info goListModule
+	if err := json.Unmarshal(result.Stdout, &info); err != nil {
+		return "", err
+	}
+
+	if info.Dir == "" {
+		return "", fmt.Errorf("unable to download %s", target)
+	}
+
+	return info.Dir, nil
+}
@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type Frontend struct {
+	PagesDirPath   string // absolute path of the Inertia pages folder
+	Framework      string // react, vue or svelte
+	TypeScript     bool
+	InertiaPackage string // @inertiajs/react
+	PackageManager string // npm, pnpm, yarn or bun, from the lock file or the frontend.package_manager setting
+	Scripts        map[string]string
+}
+
+var FrontendFrameworks = []string{"react", "vue", "svelte"}
+
+var pagesDirCandidates = []string{
+	filepath.Join("resources", "js", "Pages"),
+	filepath.Join("resources", "js", "pages"),
+	filepath.Join("resources", "ts", "Pages"),
+	filepath.Join("resources", "ts", "pages"),
+	filepath.Join("frontend", "src", "Pages"),
+	filepath.Join("frontend", "src", "pages"),
+	filepath.Join("src", "Pages"),
+	filepath.Join("src", "pages"),
+}
+
+// packageManagerLockFiles maps the lock files to their package manager
+var packageManagerLockFiles = []struct{ file, manager string }{
+	{"pnpm-lock.yaml", "pnpm"},
+	{"yarn.lock", "yarn"},
+	{"bun.lockb", "bun"},
+	{"bun.lock", "bun"},
+}
+
+type packageJSON struct {
+	Scripts         map[string]string `json:"scripts"`
+	Dependencies    map[string]string `json:"dependencies"`
+	DevDependencies map[string]string `json:"devDependencies"`
+}
+
+// DetectFrontend detects the frontend framework, the language and the pages folder of a Refiber project.
+// Framework is empty when it can not be detected from package.json
+func DetectFrontend(projectPath string) (*Frontend, error) {
+	fe := &Frontend{
+		PagesDirPath: filepath.Join(projectPath, pagesDirCandidates[0]),
+	}
+
+	for _, dir := range pagesDirCandidates {
+		p := filepath.Join(projectPath, dir)
+		if DoesDirectoryOrFileExist(p) {
+			fe.PagesDirPath = p
+			break
+		}
+	}
+
+	var pkg packageJSON
+	if content, err := os.ReadFile(filepath.Join(projectPath, "package.json")); err == nil {
+		if err := json.Unmarshal(content, &pkg); err != nil {
+			return nil, fmt.Errorf("unable to read package.json: %w", err)
+		}
+	}
+
+	hasDependency := func(name string) bool {
+		if _, ok := pkg.Dependencies[name]; ok {
+			return true
+		}
+		_, ok := pkg.DevDependencies[name]
+		return ok
+	}
+
+	for _, adapter := range []string{"@inertiajs/react", "@inertiajs/vue3", "@inertiajs/vue2", "@inertiajs/svelte"} {
+		if hasDependency(adapter) {
+			fe.InertiaPackage = adapter
+			fe.Framework = strings.TrimRight(strings.TrimPrefix(adapter, "@inertiajs/"), "23")
+			break
+		}
+	}
+
+	if fe.Framework == "" {
+		for _, framework := range FrontendFrameworks {
+			if hasDependency(framework) {
+				fe.Framework = framework
+				break
+			}
+		}
+	}
+
+	if fe.Framework != "" && fe.InertiaPackage == "" {
+		fe.InertiaPackage = InertiaPackage(fe.Framework)
+	}
+
+	fe.Scripts = pkg.Scripts
+
+	// the lock file wins over the setting, unless the package manager is passed as a flag
+	preferred := GetConfig().Lookup(ConfigPackageManager)
+	fe.PackageManager = preferred.Value
+	if preferred.Layer != ConfigLayerFlag {
+		for _, lock := range packageManagerLockFiles {
+			if DoesDirectoryOrFileExist(filepath.Join(projectPath, lock.file)) {
+				fe.PackageManager = lock.manager
+				break
+			}
+		}
+	}
+
+	fe.TypeScript = hasDependency("typescript") || DoesDirectoryOrFileExist(filepath.Join(projectPath, "tsconfig.json"))
+
+	return fe, nil
+}
+
+func InertiaPackage(framework string) string {
+	if framework == "vue" {
+		return "@inertiajs/vue3"
+	}
+
+	return "@inertiajs/" + framework
+}
+
+// PageFileExtension returns the file extension of a page component
+func (fe *Frontend) PageFileExtension() string {
+	switch fe.Framework {
+	case "vue":
+		return ".vue"
+	case "svelte":
+		return ".svelte"
+	}
+
+	if fe.TypeScript {
+		return ".tsx"
+	}
+	return ".jsx"
+}
@@ -0,0 +1,191 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+var irregularPlurals = map[string]string{
+	"person": "people",
+	"man":    "men",
+	"woman":  "women",
+	"child":  "children",
+	"tooth":  "teeth",
+	"foot":   "feet",
+	"mouse":  "mice",
+	"goose":  "geese",
+	"ox":     "oxen",
+	"knife":  "knives",
+	"life":   "lives",
+	"wife":   "wives",
+	"leaf":   "leaves",
+	"half":   "halves",
+	"wolf":   "wolves",
+	"shelf":  "shelves",
+	"status": "statuses",
+	"index":  "indices",
+}
+
+var uncountables = map[string]bool{
+	"equipment":   true,
+	"information": true,
+	"rice":        true,
+	"money":       true,
+	"species":     true,
+	"series":      true,
+	"fish":        true,
+	"sheep":       true,
+	"news":        true,
+	"data":        true,
+	"media":       true,
+}
+
+// Pluralize returns the plural form of an english word, keeping the case of the first letter
+func Pluralize(word string) string {
+	if word == "" {
+		return word
+	}
+
+	prefix, last := splitLastWord(word)
+	lower := strings.ToLower(last)
+
+	if uncountables[lower] {
+		return word
+	}
+	if plural, ok := irregularPlurals[lower]; ok {
+		return prefix + matchFirstCase(last, plural)
+	}
+
+	switch {
+	case hasAnySuffix(lower, "s", "x", "z", "ch", "sh"):
+		return word + "es"
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !isVowel(rune(lower[len(lower)-2])):
+		return word[:len(word)-1] + "ies"
+	}
+
+	return word + "s"
+}
+
+// Singularize returns the singular form of an english word, keeping the case of the first letter
+func Singularize(word string) string {
+	if word == "" {
+		return word
+	}
+
+	prefix, last := splitLastWord(word)
+	lower := strings.ToLower(last)
+
+	if uncountables[lower] {
+		return word
+	}
+	for singular, plural := range irregularPlurals {
+		if lower == plural {
+			return prefix + matchFirstCase(last, singular)
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(lower, "ies") && len(lower) > 3:
+		return word[:len(word)-3] + "y"
+	case hasAnySuffix(lower, "sses", "xes", "zes", "ches", "shes"):
+		return word[:len(word)-2]
+	case strings.HasSuffix(lower, "ss"), strings.HasSuffix(lower, "us"):
+		return word
+	case strings.HasSuffix(lower, "s"):
+		return word[:len(word)-1]
+	}
+
+	return word
+}
+
+func splitLastWord(word string) (prefix, last string) {
+	// ProductCategory -> Product, Category
+	for i := len(word) - 1; i > 0; i-- {
+		if unicode.IsUpper(rune(word[i])) || word[i] == '_' || word[i] == '-' || word[i] == ' ' {
+			if word[i] == '_' || word[i] == '-' || word[i] == ' ' {
+				return word[:i+1], word[i+1:]
+			}
+			return word[:i], word[i:]
+		}
+	}
+
+	return "", word
+}
+
+func matchFirstCase(source, target string) string {
+	if source != "" && unicode.IsUpper(rune(source[0])) {
+		return strings.ToUpper(target[:1]) + target[1:]
+	}
+
+	return target
+}
+
+func hasAnySuffix(s string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aeiou", r)
+}
+
+// SplitWords splits camelCase, PascalCase, snake_case, kebab-case and space separated words
+func SplitWords(s string) []string {
+	var words []string
+	var current []rune
+
+	runes := []rune(s)
+	for i, r := range runes {
+		if r == '_' || r == '-' || r == ' ' || r == '/' || r == '.' {
+			if len(current) > 0 {
+				words = append(words, string(current))
+				current = nil
+			}
+			continue
+		}
+
+		if unicode.IsUpper(r) && len(current) > 0 {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// HTTPServer -> HTTP, Server
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				words = append(words, string(current))
+				current = nil
+			}
+		}
+
+		current = append(current, r)
+	}
+
+	if len(current) > 0 {
+		words = append(words, string(current))
+	}
+
+	return words
+}
+
+func ToSnakeCase(s string) string {
+	return strings.ToLower(strings.Join(SplitWords(s), "_"))
+}
+
+func ToKebabCase(s string) string {
+	return strings.ToLower(strings.Join(SplitWords(s), "-"))
+}
+
+func ToPascalCase(s string) string {
+	var b strings.Builder
+	for _, w := range SplitWords(s) {
+		r := []rune(w)
+		b.WriteString(strings.ToUpper(string(r[0])) + string(r[1:]))
+	}
+	return b.String()
+}
+
+func ToCamelCase(s string) string {
+	return GetLowercaseFirstChar(ToPascalCase(s))
+}
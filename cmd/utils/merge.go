@@ -0,0 +1,97 @@
+package utils
+
+import "strings"
+
+// Merge3 merges the changes from base to theirs into ours line by line, like git merge-file.
+// The conflicting changes are written between conflict markers labeled with the names
+func Merge3(base, ours, theirs []byte, oursName, theirsName string) (merged []byte, conflicts int) {
+	baseLines := splitLines(string(base))
+	oursLines := splitLines(string(ours))
+	theirsLines := splitLines(string(theirs))
+
+	matchOurs := matchBaseLines(baseLines, oursLines)
+	matchTheirs := matchBaseLines(baseLines, theirsLines)
+
+	var out []string
+	emit := func(lines []string) {
+		out = append(out, lines...)
+	}
+
+	i, o, t := 0, 0, 0
+	for {
+		// the next base line kept by both sides
+		j := i
+		for j < len(baseLines) && (matchOurs[j] < 0 || matchTheirs[j] < 0) {
+			j++
+		}
+
+		oEnd, tEnd := len(oursLines), len(theirsLines)
+		if j < len(baseLines) {
+			oEnd, tEnd = matchOurs[j], matchTheirs[j]
+		}
+
+		b, ours, theirs := baseLines[i:j], oursLines[o:oEnd], theirsLines[t:tEnd]
+		switch {
+		case equalLines(ours, b):
+			emit(theirs)
+		case equalLines(theirs, b), equalLines(ours, theirs):
+			emit(ours)
+		default:
+			conflicts++
+			emit([]string{"<<<<<<< " + oursName})
+			emit(ours)
+			emit([]string{"======="})
+			emit(theirs)
+			emit([]string{">>>>>>> " + theirsName})
+		}
+
+		if j == len(baseLines) {
+			break
+		}
+
+		emit(baseLines[j : j+1])
+		i, o, t = j+1, oEnd+1, tEnd+1
+	}
+
+	if len(out) == 0 {
+		return nil, conflicts
+	}
+
+	return []byte(strings.Join(out, "\n") + "\n"), conflicts
+}
+
+// matchBaseLines returns the index in other of every line of base, or -1 when the line was changed
+func matchBaseLines(base, other []string) []int {
+	match := make([]int, len(base))
+
+	i, j := 0, 0
+	for _, op := range diffLines(base, other) {
+		switch op.kind {
+		case ' ':
+			match[i] = j
+			i++
+			j++
+		case '-':
+			match[i] = -1
+			i++
+		case '+':
+			j++
+		}
+	}
+
+	return match
+}
+
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,102 @@
+package utils
+
+import "testing"
+
+func TestMerge3(t *testing.T) {
+	cases := []struct {
+		name      string
+		base      string
+		ours      string
+		theirs    string
+		want      string
+		conflicts int
+	}{
+		{
+			name:   "unchanged",
+			base:   "a\nb\nc\n",
+			ours:   "a\nb\nc\n",
+			theirs: "a\nb\nc\n",
+			want:   "a\nb\nc\n",
+		},
+		{
+			name:   "changed by theirs",
+			base:   "a\nb\nc\n",
+			ours:   "a\nb\nc\n",
+			theirs: "a\nB\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "changed by ours",
+			base:   "a\nb\nc\n",
+			ours:   "a\nB\nc\n",
+			theirs: "a\nb\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "different lines changed by both",
+			base:   "a\nb\nc\nd\ne\n",
+			ours:   "A\nb\nc\nd\ne\n",
+			theirs: "a\nb\nc\nd\nE\n",
+			want:   "A\nb\nc\nd\nE\n",
+		},
+		{
+			name:   "same change on both sides",
+			base:   "a\nb\nc\n",
+			ours:   "a\nB\nc\n",
+			theirs: "a\nB\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "lines added by theirs and removed by ours",
+			base:   "a\nb\nc\nd\n",
+			ours:   "a\nc\nd\n",
+			theirs: "a\nb\nc\nd\ne\nf\n",
+			want:   "a\nc\nd\ne\nf\n",
+		},
+		{
+			name:      "same line changed differently",
+			base:      "a\nb\nc\n",
+			ours:      "a\nours\nc\n",
+			theirs:    "a\ntheirs\nc\n",
+			want:      "a\n<<<<<<< project\nours\n=======\ntheirs\n>>>>>>> v0.2.0\nc\n",
+			conflicts: 1,
+		},
+		{
+			name:      "line changed by ours and removed by theirs",
+			base:      "a\nb\nc\n",
+			ours:      "a\nB\nc\n",
+			theirs:    "a\nc\n",
+			want:      "a\n<<<<<<< project\nB\n=======\n>>>>>>> v0.2.0\nc\n",
+			conflicts: 1,
+		},
+		{
+			name:      "added on both sides without a base",
+			base:      "",
+			ours:      "ours\n",
+			theirs:    "theirs\n",
+			want:      "<<<<<<< project\nours\n=======\ntheirs\n>>>>>>> v0.2.0\n",
+			conflicts: 1,
+		},
+		{
+			name:      "two conflicts",
+			base:      "a\nb\nc\nd\ne\n",
+			ours:      "A1\nb\nc\nd\nE1\n",
+			theirs:    "A2\nb\nc\nd\nE2\n",
+			want:      "<<<<<<< project\nA1\n=======\nA2\n>>>>>>> v0.2.0\nb\nc\nd\n<<<<<<< project\nE1\n=======\nE2\n>>>>>>> v0.2.0\n",
+			conflicts: 2,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicts := Merge3([]byte(tt.base), []byte(tt.ours), []byte(tt.theirs), "project", "v0.2.0")
+
+			if string(merged) != tt.want {
+				t.Errorf("merged\n%s\nwant\n%s", merged, tt.want)
+			}
+			if conflicts != tt.conflicts {
+				t.Errorf("returned %d conflicts, want %d", conflicts, tt.conflicts)
+			}
+		})
+	}
+}
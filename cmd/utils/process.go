@@ -0,0 +1,204 @@
+package utils
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+type ProcessState string
+
+const (
+	ProcessStarting    ProcessState = "starting"
+	ProcessRunning     ProcessState = "running"
+	ProcessCrashed     ProcessState = "crashed"
+	ProcessStopped     ProcessState = "stopped"
+	ProcessBuilding    ProcessState = "building"
+	ProcessBuildFailed ProcessState = "build failed"
+)
+
+// ProcessEvent is an output line or a state change of a supervised process
+type ProcessEvent struct {
+	Process string
+	Line    string // empty for a state change
+	Error   bool   // the line is an error, e.g. a compile error
+	State   ProcessState
+	Err     error
+}
+
+// Process is a long running command restarted when it crashes, e.g. the frontend dev server
+type Process struct {
+	Name    string
+	Command string
+	Args    []string
+	Dir     string
+	Env     []string // added to the environment of refiber-cli
+
+	// StopTimeout is the time given to the process to exit after the interrupt signal before it is killed
+	StopTimeout time.Duration
+
+	mu       sync.Mutex
+	cmd      *exec.Cmd
+	signaled bool
+}
+
+const (
+	processMinRestartDelay = 500 * time.Millisecond
+	processMaxRestartDelay = 10 * time.Second
+)
+
+// CommandLine returns the command of the process as typed in a shell
+func (p *Process) CommandLine() string {
+	return strings.Join(append([]string{p.Command}, p.Args...), " ")
+}
+
+// Supervise runs the process until the context is canceled, a crashed process
+// is restarted with a delay growing while it keeps crashing. When the context is
+// canceled the process receives an interrupt, unless it was already signaled
+// with Signal, and it is killed when it does not exit within StopTimeout
+func (p *Process) Supervise(ctx context.Context, events chan<- ProcessEvent) {
+	delay := processMinRestartDelay
+
+	for {
+		events <- ProcessEvent{Process: p.Name, State: ProcessStarting}
+
+		startedAt := time.Now()
+		err := p.run(ctx, events)
+
+		if ctx.Err() != nil {
+			events <- ProcessEvent{Process: p.Name, State: ProcessStopped}
+			return
+		}
+
+		if err == nil {
+			err = fmt.Errorf("%s exited", p.CommandLine())
+		}
+
+		// a process running for a while is not crashing in a loop
+		if time.Since(startedAt) > processMaxRestartDelay {
+			delay = processMinRestartDelay
+		}
+
+		events <- ProcessEvent{Process: p.Name, State: ProcessCrashed, Err: fmt.Errorf("%w, restarting in %s", err, delay)}
+
+		select {
+		case <-ctx.Done():
+			events <- ProcessEvent{Process: p.Name, State: ProcessStopped}
+			return
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > processMaxRestartDelay {
+			delay = processMaxRestartDelay
+		}
+	}
+}
+
+// Signal forwards a signal to the process and its children
+func (p *Process) Signal(sig os.Signal) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cmd == nil || p.cmd.Process == nil {
+		return nil
+	}
+
+	p.signaled = true
+	return signalProcessGroup(p.cmd, sig)
+}
+
+func (p *Process) run(ctx context.Context, events chan<- ProcessEvent) error {
+	cmd := exec.Command(p.Command, p.Args...)
+	cmd.Dir = p.Dir
+	cmd.Env = append(os.Environ(), p.Env...)
+	setProcessGroup(cmd)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.cmd, p.signaled = cmd, false
+	p.mu.Unlock()
+
+	events <- ProcessEvent{Process: p.Name, State: ProcessRunning}
+
+	var wg sync.WaitGroup
+	for _, r := range []io.Reader{stdout, stderr} {
+		wg.Add(1)
+		go func(r io.Reader) {
+			defer wg.Done()
+			p.scanLines(r, events)
+		}(r)
+	}
+
+	// stops the process when the context is canceled, killing it after the timeout
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			p.mu.Lock()
+			signaled := p.signaled
+			p.mu.Unlock()
+			if !signaled {
+				p.Signal(os.Interrupt)
+			}
+
+			timeout := p.StopTimeout
+			if timeout == 0 {
+				timeout = 5 * time.Second
+			}
+
+			select {
+			case <-done:
+			case <-time.After(timeout):
+				p.Signal(os.Kill)
+			}
+		}
+	}()
+
+	wg.Wait()
+	err = cmd.Wait()
+	close(done)
+
+	p.mu.Lock()
+	p.cmd = nil
+	p.mu.Unlock()
+
+	return err
+}
+
+func (p *Process) scanLines(r io.Reader, events chan<- ProcessEvent) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		// the dev servers redraw their status with carriage returns
+		line := scanner.Text()
+		if i := strings.LastIndex(line, "\r"); i >= 0 && i < len(line)-1 {
+			line = line[i+1:]
+		}
+		line = strings.TrimRight(line, "\r")
+
+		events <- ProcessEvent{Process: p.Name, Line: line}
+	}
+
+	// drains the pipe so the process never blocks on a line too long for the scanner
+	io.Copy(io.Discard, r)
+}
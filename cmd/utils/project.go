@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+)
+
+const FrameworkModulePath = "github.com/refiber/framework"
+
+// IsRefiberProject reports whether the folder has a go.mod requiring the framework
+func IsRefiberProject(dir string) bool {
+	return HasModuleDependency(dir, FrameworkModulePath)
+}
+
+// FindProjectRoot walks upward from the folder to the nearest go.mod requiring the framework.
+// A go.work workspace is resolved to its Refiber module, when there is only one
+func FindProjectRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for d := dir; ; d = filepath.Dir(d) {
+		if IsRefiberProject(d) {
+			return d, nil
+		}
+
+		if workFilePath := filepath.Join(d, "go.work"); DoesDirectoryOrFileExist(workFilePath) && os.Getenv("GOWORK") != "off" {
+			root, err := findWorkspaceProject(workFilePath)
+			if err != nil || root != "" {
+				return root, err
+			}
+		}
+
+		if d == filepath.Dir(d) {
+			break
+		}
+	}
+
+	// a workspace outside of the folder tree
+	if workFilePath := os.Getenv("GOWORK"); workFilePath != "" && workFilePath != "off" {
+		root, err := findWorkspaceProject(workFilePath)
+		if err != nil || root != "" {
+			return root, err
+		}
+	}
+
+	return "", fmt.Errorf("the current folder path is not inside the Refiber project")
+}
+
+// findWorkspaceProject returns the only Refiber module used by the workspace,
+// or an empty string when the workspace doesn't use one
+func findWorkspaceProject(workFilePath string) (string, error) {
+	projects, err := GetWorkspaceProjects(workFilePath)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(projects) {
+	case 0:
+		return "", nil
+	case 1:
+		return projects[0], nil
+	}
+
+	workDir := filepath.Dir(workFilePath)
+	var names []string
+	for _, p := range projects {
+		rel, err := filepath.Rel(workDir, p)
+		if err != nil {
+			rel = p
+		}
+		names = append(names, rel)
+	}
+
+	return "", fmt.Errorf("the go.work uses several Refiber projects (%s), run the command inside one of them or use the --project flag", strings.Join(names, ", "))
+}
+
+// GetWorkspaceProjects returns the folders of the Refiber modules used by a go.work file
+func GetWorkspaceProjects(workFilePath string) ([]string, error) {
+	content, err := os.ReadFile(workFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	work, err := modfile.ParseWork(workFilePath, content, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []string
+	for _, use := range work.Use {
+		dir := filepath.FromSlash(use.Path)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(filepath.Dir(workFilePath), dir)
+		}
+
+		if IsRefiberProject(dir) {
+			projects = append(projects, filepath.Clean(dir))
+		}
+	}
+
+	return projects, nil
+}
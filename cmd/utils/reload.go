@@ -0,0 +1,177 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/refiber/refiber-cli/cmd/runner"
+)
+
+// Reloader builds the Go server and restarts it when a watched file changes,
+// the last good build keeps running while the new one does not compile
+type Reloader struct {
+	Name      string
+	Dir       string // root of the project
+	Package   string // main package, e.g. .
+	BinDir    string // folder of the builds, e.g. tmp/refiber-dev
+	BuildArgs []string
+	Env       []string
+	Watch     *WatchOptions
+
+	mu      sync.Mutex
+	process *Process
+}
+
+// Supervise builds and runs the server until the context is canceled, see Process.Supervise
+func (r *Reloader) Supervise(ctx context.Context, events chan<- ProcessEvent) {
+	changes := make(chan []string, 1)
+
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- WatchChanges(ctx, r.Dir, r.Watch, func(changed []string) {
+			// merges the changes while a build is running
+			select {
+			case previous := <-changes:
+				changed = append(previous, changed...)
+			default:
+			}
+			changes <- changed
+		})
+	}()
+
+	var stopServer func()
+	defer func() {
+		// the running server reports that it stopped
+		if stopServer != nil {
+			stopServer()
+			return
+		}
+		events <- ProcessEvent{Process: r.Name, State: ProcessStopped}
+	}()
+
+	changed := []string{}
+	for {
+		events <- ProcessEvent{Process: r.Name, State: ProcessBuilding}
+		if len(changed) > 0 {
+			events <- ProcessEvent{Process: r.Name, Line: fmt.Sprintf("%s changed, rebuilding", summarizeChanges(changed))}
+		}
+
+		binPath, err := r.build(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			events <- ProcessEvent{Process: r.Name, State: ProcessBuildFailed}
+			for _, line := range strings.Split(strings.TrimRight(err.Error(), "\n"), "\n") {
+				events <- ProcessEvent{Process: r.Name, Line: line, Error: true}
+			}
+			if stopServer != nil {
+				events <- ProcessEvent{Process: r.Name, Line: "serving the last good build", Error: true}
+			}
+		default:
+			// the new build replaces the running server once it stopped gracefully
+			if stopServer != nil {
+				stopServer()
+			}
+			if err := os.Rename(binPath, r.binPath()); err != nil {
+				events <- ProcessEvent{Process: r.Name, Line: err.Error(), Error: true}
+			}
+			stopServer = r.start(ctx, events)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-watchErr:
+			if err != nil {
+				events <- ProcessEvent{Process: r.Name, State: ProcessCrashed, Err: fmt.Errorf("watcher stopped: %w", err)}
+			}
+			<-ctx.Done()
+			return
+		case changed = <-changes:
+		}
+	}
+}
+
+// Signal forwards a signal to the running server
+func (r *Reloader) Signal(sig os.Signal) error {
+	r.mu.Lock()
+	p := r.process
+	r.mu.Unlock()
+
+	if p == nil {
+		return nil
+	}
+
+	return p.Signal(sig)
+}
+
+// build compiles the main package next to the running server, the compile errors are returned
+func (r *Reloader) build(ctx context.Context) (string, error) {
+	if err := os.MkdirAll(r.BinDir, 0755); err != nil {
+		return "", err
+	}
+
+	binPath := r.binPath() + ".next"
+
+	args := append([]string{"build", "-o", binPath}, r.BuildArgs...)
+	result, err := runner.Run(ctx, &runner.Command{
+		Name: "go",
+		Args: append(args, r.Package),
+		Dir:  r.Dir,
+		Env:  r.Env,
+	})
+	if err != nil {
+		// the compile errors without the command line, they are shown inline
+		if result == nil || len(bytes.TrimSpace(result.Stderr)) == 0 {
+			return "", err
+		}
+		return "", fmt.Errorf("%s", result.Stderr)
+	}
+
+	return binPath, nil
+}
+
+// start runs the last good build, the returned function stops it and waits for it to exit
+func (r *Reloader) start(ctx context.Context, events chan<- ProcessEvent) func() {
+	p := &Process{Name: r.Name, Command: r.binPath(), Dir: r.Dir, Env: r.Env}
+
+	r.mu.Lock()
+	r.process = p
+	r.mu.Unlock()
+
+	processCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		p.Supervise(processCtx, events)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func (r *Reloader) binPath() string {
+	name := "main"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+
+	return filepath.Join(r.BinDir, name)
+}
+
+func summarizeChanges(changed []string) string {
+	if len(changed) == 1 {
+		return changed[0]
+	}
+
+	return fmt.Sprintf("%s and %d other files", changed[0], len(changed)-1)
+}
@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RequestField is a field of a request struct, read from its tags
+type RequestField struct {
+	Name  string   // product_name, the json name of the field
+	Type  string   // string
+	Rules []string // required, max=255
+}
+
+// ActionRequest finds the request parsed by an action of a controller, e.g. new(requests.StoreProductRequest),
+// and returns the import path of its package and its name
+func ActionRequest(source []byte, action string) (importPath, name string, ok bool) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", source, 0)
+	if err != nil {
+		return "", "", false
+	}
+
+	imports := fileImports(file)
+	for _, decl := range file.Decls {
+		fn, isFunc := decl.(*ast.FuncDecl)
+		if !isFunc || fn.Recv == nil || fn.Name.Name != action || fn.Body == nil {
+			continue
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if call, isCall := n.(*ast.CallExpr); isCall && !ok && len(call.Args) == 1 {
+				fun, isIdent := call.Fun.(*ast.Ident)
+				sel, isSel := call.Args[0].(*ast.SelectorExpr)
+				if isIdent && fun.Name == "new" && isSel {
+					if pkg, isPkg := sel.X.(*ast.Ident); isPkg && imports[pkg.Name] != "" {
+						importPath, name, ok = imports[pkg.Name], sel.Sel.Name, true
+					}
+				}
+			}
+			return !ok
+		})
+	}
+
+	return importPath, name, ok
+}
+
+// ParseRequestFields returns the fields of the request struct declared in the source
+func ParseRequestFields(source []byte, name string) ([]*RequestField, bool) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", source, 0)
+	if err != nil {
+		return nil, false
+	}
+
+	for _, decl := range file.Decls {
+		gen, isGen := decl.(*ast.GenDecl)
+		if !isGen || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			structType, isStruct := typeSpec.Type.(*ast.StructType)
+			if typeSpec.Name.Name != name || !isStruct {
+				continue
+			}
+
+			var fields []*RequestField
+			for _, field := range structType.Fields.List {
+				for _, ident := range field.Names {
+					if !ident.IsExported() {
+						continue
+					}
+
+					f := &RequestField{Name: ident.Name, Type: types.ExprString(field.Type)}
+					if field.Tag != nil {
+						tag, _ := strconv.Unquote(field.Tag.Value)
+						if jsonName, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ","); jsonName == "-" {
+							continue
+						} else if jsonName != "" {
+							f.Name = jsonName
+						}
+						if rules := reflect.StructTag(tag).Get("validate"); rules != "" {
+							f.Rules = strings.Split(rules, ",")
+						}
+					}
+					fields = append(fields, f)
+				}
+			}
+
+			return fields, true
+		}
+	}
+
+	return nil, false
+}
+
+// Optional reports whether the field may be left out of the request
+func (f *RequestField) Optional() bool {
+	return contains(f.Rules, "omitempty")
+}
+
+// SampleValue returns a value of the field passing its validation rules
+func (f *RequestField) SampleValue() any {
+	minimum := 0
+	for _, rule := range f.Rules {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "email":
+			return "test@example.com"
+		case "url", "http_url":
+			return "https://example.com"
+		case "uuid", "uuid4":
+			return "123e4567-e89b-42d3-a456-426614174000"
+		case "datetime":
+			return time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC).Format(param)
+		case "oneof":
+			if options := strings.Fields(param); len(options) > 0 {
+				return options[0]
+			}
+		case "min", "gte", "len":
+			minimum, _ = strconv.Atoi(param)
+		}
+	}
+
+	switch f.Type {
+	case "bool":
+		return true
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return max(minimum, 1)
+	case "map[string]any", "map[string]interface{}":
+		return map[string]any{"key": "value"}
+	}
+
+	if strings.HasPrefix(f.Type, "[]") {
+		return []any{}
+	}
+
+	if minimum > 4 {
+		return strings.Repeat("a", minimum)
+	}
+	return "test"
+}
@@ -0,0 +1,566 @@
+package utils
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Route struct {
+	Method          string   `json:"method"`
+	Path            string   `json:"path"`
+	Handler         string   `json:"handler"`
+	Controller      string   `json:"controller,omitempty"`
+	Middleware      []string `json:"middleware"`
+	Location        string   `json:"location"`
+	HandlerLocation string   `json:"handlerLocation,omitempty"`
+}
+
+type ResourceAction struct {
+	Name   string // Index
+	Method string // GET
+	Path   string // suffix appended to the resource path, e.g. /:id/edit
+}
+
+// ResourceActions are the actions registered by a resource route, in the same order
+// as the methods of the CRUD controller template
+var ResourceActions = []ResourceAction{
+	{Name: "Index", Method: "GET", Path: ""},
+	{Name: "Create", Method: "GET", Path: "/create"},
+	{Name: "Store", Method: "POST", Path: ""},
+	{Name: "Show", Method: "GET", Path: "/:id"},
+	{Name: "Edit", Method: "GET", Path: "/:id/edit"},
+	{Name: "Update", Method: "PUT", Path: "/:id"},
+	{Name: "Destroy", Method: "DELETE", Path: "/:id"},
+}
+
+var routeMethods = map[string]string{
+	"Get":     "GET",
+	"Head":    "HEAD",
+	"Post":    "POST",
+	"Put":     "PUT",
+	"Patch":   "PATCH",
+	"Delete":  "DELETE",
+	"Connect": "CONNECT",
+	"Options": "OPTIONS",
+	"Trace":   "TRACE",
+	"All":     "ALL",
+}
+
+type routeScope struct {
+	prefix     string
+	middleware []string
+}
+
+type routeParser struct {
+	projectPath string
+	fset        *token.FileSet
+	controllers *controllerIndex
+	routes      []*Route
+
+	// per file
+	imports map[string]string
+	// per function
+	scopes map[string]*routeScope
+	values map[string]ast.Expr
+}
+
+// ParseRoutes statically analyzes the routes package of a Refiber project
+// without compiling or running it
+func ParseRoutes(projectPath string) ([]*Route, error) {
+	routesDirPath := filepath.Join(projectPath, "routes")
+	if !DoesDirectoryOrFileExist(routesDirPath) {
+		return nil, fmt.Errorf("routes folder not found. Make sure you are inside the Refiber project")
+	}
+
+	p := &routeParser{
+		projectPath: projectPath,
+		fset:        token.NewFileSet(),
+	}
+
+	controllers, err := indexControllers(p.fset, filepath.Join(projectPath, "app", "controllers"))
+	if err != nil {
+		return nil, err
+	}
+	p.controllers = controllers
+
+	files, err := parseGoFiles(p.fset, routesDirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		p.imports = fileImports(file)
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+
+			p.scopes = map[string]*routeScope{}
+			p.values = map[string]ast.Expr{}
+			p.walk(fn.Body, &routeScope{})
+		}
+	}
+
+	return p.routes, nil
+}
+
+func (p *routeParser) walk(node ast.Node, scope *routeScope) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			p.assign(n, scope)
+
+		case *ast.CallExpr:
+			return p.call(n, scope)
+		}
+
+		return true
+	})
+}
+
+func (p *routeParser) assign(stmt *ast.AssignStmt, scope *routeScope) {
+	if len(stmt.Lhs) != len(stmt.Rhs) {
+		return
+	}
+
+	for i, lhs := range stmt.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok || ident.Name == "_" {
+			continue
+		}
+
+		p.values[ident.Name] = stmt.Rhs[i]
+
+		// api := r.Group("/api", middleware.Auth)
+		if call, ok := stmt.Rhs[i].(*ast.CallExpr); ok {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Group" && len(call.Args) > 0 {
+				parent := p.receiverScope(sel.X, scope)
+				p.scopes[ident.Name] = p.childScope(parent, call.Args[0], call.Args[1:])
+			}
+		}
+	}
+}
+
+func (p *routeParser) call(call *ast.CallExpr, scope *routeScope) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return true
+	}
+
+	name := sel.Sel.Name
+	receiver := p.receiverScope(sel.X, scope)
+
+	if method, ok := routeMethods[name]; ok && len(call.Args) > 1 {
+		path, ok := p.stringValue(call.Args[0])
+		if !ok {
+			return true
+		}
+
+		handlers := call.Args[1:]
+		handler := handlers[len(handlers)-1]
+
+		route := &Route{
+			Method:     method,
+			Path:       joinRoutePath(receiver.prefix, path),
+			Handler:    types.ExprString(handler),
+			Middleware: append(append([]string{}, receiver.middleware...), exprStrings(handlers[:len(handlers)-1])...),
+			Location:   p.location(call.Pos()),
+		}
+		route.Controller, route.HandlerLocation = p.resolveHandler(handler)
+		p.routes = append(p.routes, route)
+
+		return false
+	}
+
+	switch name {
+	case "Use":
+		// r.Use(middleware.Auth) applies to every route registered after it
+		var middleware []ast.Expr
+		for _, arg := range call.Args {
+			if _, ok := arg.(*ast.BasicLit); !ok {
+				middleware = append(middleware, arg)
+			}
+		}
+		receiver.middleware = append(receiver.middleware, exprStrings(middleware)...)
+		return false
+
+	case "Group", "Route":
+		if len(call.Args) < 1 {
+			return true
+		}
+
+		var fnLit *ast.FuncLit
+		var middleware []ast.Expr
+		for _, arg := range call.Args[1:] {
+			if f, ok := arg.(*ast.FuncLit); ok && fnLit == nil {
+				fnLit = f
+				continue
+			}
+			middleware = append(middleware, arg)
+		}
+
+		if fnLit == nil {
+			// handled by assign when the group is stored in a variable
+			return false
+		}
+
+		child := p.childScope(receiver, call.Args[0], middleware)
+		if len(fnLit.Type.Params.List) > 0 && len(fnLit.Type.Params.List[0].Names) > 0 {
+			// the parameter usually shadows the outer router, e.g. func(r router.RouterInterface)
+			param := fnLit.Type.Params.List[0].Names[0].Name
+			outer, shadowed := p.scopes[param]
+			p.scopes[param] = child
+			defer func() {
+				if shadowed {
+					p.scopes[param] = outer
+				} else {
+					delete(p.scopes, param)
+				}
+			}()
+		}
+		p.walk(fnLit.Body, child)
+		return false
+
+	case "Resource":
+		if len(call.Args) < 2 {
+			return true
+		}
+
+		path, ok := p.stringValue(call.Args[0])
+		if !ok {
+			return true
+		}
+
+		controller := call.Args[1]
+		middleware := append(append([]string{}, receiver.middleware...), exprStrings(call.Args[2:])...)
+
+		for _, action := range ResourceActions {
+			handler := &ast.SelectorExpr{X: controller, Sel: ast.NewIdent(action.Name)}
+
+			route := &Route{
+				Method:     action.Method,
+				Path:       joinRoutePath(receiver.prefix, path+action.Path),
+				Handler:    types.ExprString(handler),
+				Middleware: middleware,
+				Location:   p.location(call.Pos()),
+			}
+			route.Controller, route.HandlerLocation = p.resolveHandler(handler)
+			p.routes = append(p.routes, route)
+		}
+		return false
+	}
+
+	return true
+}
+
+func (p *routeParser) receiverScope(x ast.Expr, scope *routeScope) *routeScope {
+	if ident, ok := x.(*ast.Ident); ok {
+		if s, ok := p.scopes[ident.Name]; ok {
+			return s
+		}
+	}
+
+	return scope
+}
+
+func (p *routeParser) childScope(parent *routeScope, prefix ast.Expr, middleware []ast.Expr) *routeScope {
+	path, _ := p.stringValue(prefix)
+
+	return &routeScope{
+		prefix:     joinRoutePath(parent.prefix, path),
+		middleware: append(append([]string{}, parent.middleware...), exprStrings(middleware)...),
+	}
+}
+
+func (p *routeParser) stringValue(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+		s, err := strconv.Unquote(e.Value)
+		return s, err == nil
+
+	case *ast.Ident:
+		if v, ok := p.values[e.Name]; ok {
+			return p.stringValue(v)
+		}
+		if e.Obj != nil {
+			if spec, ok := e.Obj.Decl.(*ast.ValueSpec); ok {
+				for i, name := range spec.Names {
+					if name.Name == e.Name && i < len(spec.Values) {
+						return p.stringValue(spec.Values[i])
+					}
+				}
+			}
+		}
+
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return "", false
+		}
+		x, ok := p.stringValue(e.X)
+		if !ok {
+			return "", false
+		}
+		y, ok := p.stringValue(e.Y)
+		return x + y, ok
+	}
+
+	return "", false
+}
+
+// resolveHandler finds the controller name and the source location of a handler expression
+func (p *routeParser) resolveHandler(handler ast.Expr) (controller, location string) {
+	switch h := handler.(type) {
+	case *ast.Ident:
+		if v, ok := p.values[h.Name]; ok {
+			return p.resolveHandler(v)
+		}
+
+	case *ast.SelectorExpr:
+		// web.HomeController
+		if pkg, ok := h.X.(*ast.Ident); ok {
+			if importPath, ok := p.imports[pkg.Name]; ok {
+				dir := p.controllers.dirOf(importPath)
+				if pos, ok := p.controllers.funcs[dir+"."+h.Sel.Name]; ok {
+					return pkg.Name + "." + h.Sel.Name, p.location(pos)
+				}
+				return pkg.Name + "." + h.Sel.Name, ""
+			}
+		}
+
+		// web.NewProductController(s).Index, ctr.Index
+		dir, typeName, name := p.resolveControllerType(h.X)
+		if typeName == "" {
+			return types.ExprString(h.X), ""
+		}
+		if pos, ok := p.controllers.methods[dir+"."+typeName+"."+h.Sel.Name]; ok {
+			return name, p.location(pos)
+		}
+		return name, ""
+	}
+
+	return "", ""
+}
+
+func (p *routeParser) resolveControllerType(expr ast.Expr) (dir, typeName, name string) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if v, ok := p.values[e.Name]; ok {
+			return p.resolveControllerType(v)
+		}
+
+	case *ast.UnaryExpr:
+		return p.resolveControllerType(e.X)
+
+	case *ast.CompositeLit:
+		return p.resolveControllerType(e.Type)
+
+	case *ast.CallExpr:
+		// constructor, e.g. web.NewProductController(s)
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return "", "", ""
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return "", "", ""
+		}
+		dir = p.controllers.dirOf(p.imports[pkg.Name])
+		name = pkg.Name + "." + strings.TrimPrefix(sel.Sel.Name, "New")
+		return dir, p.controllers.returns[dir+"."+sel.Sel.Name], name
+
+	case *ast.SelectorExpr:
+		// web.ProductController{}
+		pkg, ok := e.X.(*ast.Ident)
+		if !ok {
+			return "", "", ""
+		}
+		dir = p.controllers.dirOf(p.imports[pkg.Name])
+		if typeName, ok := p.controllers.returns[dir+"."+e.Sel.Name]; ok {
+			// constructor passed as a value, e.g. r.Resource("/products", web.NewProductController)
+			return dir, typeName, pkg.Name + "." + strings.TrimPrefix(e.Sel.Name, "New")
+		}
+		return dir, e.Sel.Name, pkg.Name + "." + e.Sel.Name
+	}
+
+	return "", "", ""
+}
+
+func (p *routeParser) location(pos token.Pos) string {
+	position := p.fset.Position(pos)
+
+	filename := position.Filename
+	if rel, err := filepath.Rel(p.projectPath, filename); err == nil {
+		filename = rel
+	}
+
+	return fmt.Sprintf("%s:%d", filepath.ToSlash(filename), position.Line)
+}
+
+type controllerIndex struct {
+	funcs   map[string]token.Pos // dir.Func
+	methods map[string]token.Pos // dir.Type.Method
+	returns map[string]string    // dir.Func -> returned type name
+	dirs    []string
+}
+
+func indexControllers(fset *token.FileSet, controllersDirPath string) (*controllerIndex, error) {
+	index := &controllerIndex{
+		funcs:   map[string]token.Pos{},
+		methods: map[string]token.Pos{},
+		returns: map[string]string{},
+	}
+
+	if !DoesDirectoryOrFileExist(controllersDirPath) {
+		return index, nil
+	}
+
+	err := filepath.WalkDir(controllersDirPath, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(controllersDirPath, path)
+		if err != nil {
+			return err
+		}
+		dir := filepath.ToSlash(rel)
+		index.dirs = append(index.dirs, dir)
+
+		files, err := parseGoFiles(fset, path)
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok {
+					continue
+				}
+
+				if fn.Recv == nil || len(fn.Recv.List) == 0 {
+					index.funcs[dir+"."+fn.Name.Name] = fn.Pos()
+					if fn.Type.Results != nil && len(fn.Type.Results.List) > 0 {
+						index.returns[dir+"."+fn.Name.Name] = baseTypeName(fn.Type.Results.List[0].Type)
+					}
+					continue
+				}
+
+				index.methods[dir+"."+baseTypeName(fn.Recv.List[0].Type)+"."+fn.Name.Name] = fn.Pos()
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// longest directory first so nested packages win over their parents
+	sort.Slice(index.dirs, func(i, j int) bool {
+		return len(index.dirs[i]) > len(index.dirs[j])
+	})
+
+	return index, nil
+}
+
+// dirOf maps an import path to the folder of the package relative to app/controllers
+func (c *controllerIndex) dirOf(importPath string) string {
+	for _, dir := range c.dirs {
+		if strings.HasSuffix(importPath, "/app/controllers/"+dir) {
+			return dir
+		}
+	}
+
+	return ""
+}
+
+func parseGoFiles(fset *token.FileSet, dirPath string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dirPath, entry.Name()), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+func fileImports(file *ast.File) map[string]string {
+	imports := map[string]string{}
+
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := importPath[strings.LastIndex(importPath, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = importPath
+	}
+
+	return imports
+}
+
+func baseTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return baseTypeName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.IndexExpr:
+		return baseTypeName(e.X)
+	case *ast.IndexListExpr:
+		return baseTypeName(e.X)
+	}
+
+	return ""
+}
+
+func exprStrings(exprs []ast.Expr) []string {
+	s := []string{}
+	for _, e := range exprs {
+		s = append(s, types.ExprString(e))
+	}
+	return s
+}
+
+func joinRoutePath(prefix, path string) string {
+	joined := strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(path, "/")
+	if len(joined) > 1 {
+		joined = strings.TrimSuffix(joined, "/")
+	}
+	return joined
+}
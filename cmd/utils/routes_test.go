@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testControllers = `package web
+
+type ProductController struct{}
+
+func HomeController(s support.Refiber, c *fiber.Ctx) error { return nil }
+
+func NewProductController(s support.Refiber) *ProductController { return &ProductController{} }
+
+func (ctr *ProductController) Index(c *fiber.Ctx) error { return nil }
+`
+
+func TestParseRoutes(t *testing.T) {
+	cases := []struct {
+		name   string
+		routes string // the body of the routes function
+		want   []string
+	}{
+		{
+			name: "routes and middleware",
+			routes: `
+	r.Get("/", web.HomeController)
+	r.Use(middleware.Session)
+	r.Post("/login", middleware.Throttle, web.HomeController)`,
+			want: []string{
+				"GET / web.HomeController web.HomeController [] app/controllers/web/web.go:5",
+				"POST /login web.HomeController web.HomeController [middleware.Session middleware.Throttle] app/controllers/web/web.go:5",
+			},
+		},
+		{
+			name: "group in a variable with a constant prefix",
+			routes: `
+	api := r.Group(apiPrefix+"/v1", middleware.Auth)
+	api.Get("/users", web.HomeController)
+	r.Get("/", web.HomeController)`,
+			want: []string{
+				"GET /api/v1/users web.HomeController web.HomeController [middleware.Auth] app/controllers/web/web.go:5",
+				"GET / web.HomeController web.HomeController [] app/controllers/web/web.go:5",
+			},
+		},
+		{
+			name: "callbacks shadowing the router",
+			routes: `
+	r.Route("/admin", func(r router.RouterInterface) {
+		r.Group("/cache", func(r router.RouterInterface) {
+			r.Delete("/", web.HomeController)
+		})
+		r.Get("/users", web.HomeController)
+	}, middleware.Admin)
+	r.Get("/about", web.HomeController)`,
+			want: []string{
+				"DELETE /admin/cache web.HomeController web.HomeController [middleware.Admin] app/controllers/web/web.go:5",
+				"GET /admin/users web.HomeController web.HomeController [middleware.Admin] app/controllers/web/web.go:5",
+				"GET /about web.HomeController web.HomeController [] app/controllers/web/web.go:5",
+			},
+		},
+		{
+			name: "resource",
+			routes: `
+	r.Resource("/products", web.NewProductController(s), middleware.Auth)`,
+			want: []string{
+				"GET /products web.NewProductController(s).Index web.ProductController [middleware.Auth] app/controllers/web/web.go:9",
+				"GET /products/create web.NewProductController(s).Create web.ProductController [middleware.Auth] ",
+				"POST /products web.NewProductController(s).Store web.ProductController [middleware.Auth] ",
+				"GET /products/:id web.NewProductController(s).Show web.ProductController [middleware.Auth] ",
+				"GET /products/:id/edit web.NewProductController(s).Edit web.ProductController [middleware.Auth] ",
+				"PUT /products/:id web.NewProductController(s).Update web.ProductController [middleware.Auth] ",
+				"DELETE /products/:id web.NewProductController(s).Destroy web.ProductController [middleware.Auth] ",
+			},
+		},
+		{
+			name: "controller in a variable",
+			routes: `
+	ctr := web.NewProductController(s)
+	r.Get("/products", ctr.Index)`,
+			want: []string{
+				"GET /products ctr.Index web.ProductController [] app/controllers/web/web.go:9",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			projectPath := t.TempDir()
+			writeTestFile(t, filepath.Join(projectPath, "app", "controllers", "web", "web.go"), testControllers)
+			writeTestFile(t, filepath.Join(projectPath, "routes", "web.go"), `package routes
+
+import (
+	"example.com/app/app/controllers/web"
+	"example.com/app/app/middleware"
+)
+
+const apiPrefix = "/api"
+
+func SetupRouter(r router.RouterInterface, s support.Refiber) {`+tt.routes+`
+}
+`)
+
+			routes, err := ParseRoutes(projectPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, r := range routes {
+				got = append(got, fmt.Sprintf("%s %s %s %s %v %s", r.Method, r.Path, r.Handler, r.Controller, r.Middleware, r.HandlerLocation))
+			}
+
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("parsed\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestParseRoutesLocation(t *testing.T) {
+	projectPath := t.TempDir()
+	writeTestFile(t, filepath.Join(projectPath, "routes", "web.go"), `package routes
+
+func SetupRouter(r router.RouterInterface) {
+	r.Get("/", home)
+
+	r.Resource("/products", products)
+}
+`)
+
+	routes, err := ParseRoutes(projectPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(routes) != 1+len(ResourceActions) {
+		t.Fatalf("parsed %d routes, want %d", len(routes), 1+len(ResourceActions))
+	}
+	if routes[0].Location != "routes/web.go:4" {
+		t.Errorf("the route is registered at %s, want routes/web.go:4", routes[0].Location)
+	}
+	for _, r := range routes[1:] {
+		if r.Location != "routes/web.go:6" {
+			t.Errorf("the %s %s route is registered at %s, want routes/web.go:6", r.Method, r.Path, r.Location)
+		}
+	}
+}
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"fmt"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+)
+
+// the releases of the refiber/refiber scaffold and the files of a release, variables for the tests
+var (
+	scaffoldReleasesURL = "https://api.github.com/repos/refiber/refiber/releases?per_page=100"
+	scaffoldFileURL     = "https://raw.githubusercontent.com/refiber/refiber/%s/%s"
+)
+
+// FindScaffoldRelease returns the release of the refiber/refiber scaffold requiring the framework version
+// in its go.mod, the newest one when several releases require it. The scaffold and the framework are
+// released separately, their versions don't match
+func FindScaffoldRelease(frameworkVersion string) (string, error) {
+	var releases []*Release
+	if err := getJSON(scaffoldReleasesURL, &releases); err != nil {
+		return "", fmt.Errorf("unable to list the scaffold releases: %w", err)
+	}
+
+	for _, r := range releases {
+		content, err := getContent(fmt.Sprintf(scaffoldFileURL, r.Version, "go.mod"))
+		if err != nil {
+			return "", fmt.Errorf("unable to read the go.mod of the scaffold %s: %w", r.Version, err)
+		}
+
+		f, err := modfile.Parse("go.mod", content, nil)
+		if err != nil {
+			continue
+		}
+
+		for _, require := range f.Require {
+			if require.Mod.Path != FrameworkModulePath {
+				continue
+			}
+
+			switch semver.Compare(require.Mod.Version, frameworkVersion) {
+			case 0:
+				return r.Version, nil
+			case -1:
+				// the releases are listed from the newest, the older ones require older frameworks
+				return "", fmt.Errorf("no scaffold release requires %s %s", FrameworkModulePath, frameworkVersion)
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no scaffold release requires %s %s", FrameworkModulePath, frameworkVersion)
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFindScaffoldRelease(t *testing.T) {
+	// the scaffold releases from the newest, with the framework version of their go.mod
+	frameworks := map[string]string{
+		"v0.3.1": "v0.2.0",
+		"v0.3.0": "v0.2.0",
+		"v0.2.0": "v0.1.1",
+		"v0.1.0": "v0.1.0",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/releases" {
+			fmt.Fprint(w, `[{"tag_name":"v0.3.1"},{"tag_name":"v0.3.0"},{"tag_name":"v0.2.0"},{"tag_name":"v0.1.0"}]`)
+			return
+		}
+
+		release := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), "/go.mod")
+		fmt.Fprintf(w, "module bykevin.work/refiber\n\ngo 1.22\n\nrequire %s %s\n", FrameworkModulePath, frameworks[release])
+	}))
+	defer server.Close()
+
+	defer func(releasesURL, fileURL string) {
+		scaffoldReleasesURL, scaffoldFileURL = releasesURL, fileURL
+	}(scaffoldReleasesURL, scaffoldFileURL)
+	scaffoldReleasesURL = server.URL + "/releases"
+	scaffoldFileURL = server.URL + "/%s/%s"
+
+	cases := []struct {
+		framework string
+		want      string
+		err       bool
+	}{
+		{framework: "v0.2.0", want: "v0.3.1"},
+		{framework: "v0.1.1", want: "v0.2.0"},
+		{framework: "v0.1.0", want: "v0.1.0"},
+		{framework: "v0.1.2", err: true},
+		{framework: "v0.9.0", err: true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.framework, func(t *testing.T) {
+			got, err := FindScaffoldRelease(tt.framework)
+			if tt.err {
+				if err == nil {
+					t.Errorf("returned %s, want an error", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("returned %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
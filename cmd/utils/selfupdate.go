@@ -0,0 +1,323 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const (
+	InstallMethodGo       = "go"       // go install
+	InstallMethodHomebrew = "homebrew" // brew install
+	InstallMethodScoop    = "scoop"
+	InstallMethodNix      = "nix"
+	InstallMethodBinary   = "binary" // a release binary
+)
+
+// InstallMethod describes how the running refiber-cli was installed
+type InstallMethod struct {
+	Name       string
+	Executable string // resolved path of the running executable
+	UpdateHint string // command updating a package manager install
+}
+
+// IsPackageManager reports whether the executable is owned by a package manager
+func (m *InstallMethod) IsPackageManager() bool {
+	return m.UpdateHint != ""
+}
+
+// DetectInstallMethod detects how refiber-cli was installed from the path of the executable
+func DetectInstallMethod() (*InstallMethod, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+
+	m := &InstallMethod{Name: InstallMethodBinary, Executable: exe}
+	slashed := filepath.ToSlash(exe)
+
+	switch {
+	case strings.Contains(slashed, "/Cellar/") || strings.Contains(slashed, "/homebrew/") || strings.Contains(slashed, "/linuxbrew/"):
+		m.Name, m.UpdateHint = InstallMethodHomebrew, "brew upgrade refiber-cli"
+	case strings.Contains(strings.ToLower(slashed), "/scoop/"):
+		m.Name, m.UpdateHint = InstallMethodScoop, "scoop update refiber-cli"
+	case strings.HasPrefix(slashed, "/nix/store/"):
+		m.Name, m.UpdateHint = InstallMethodNix, "nix profile upgrade refiber-cli"
+	case Version == "" && isInGoBin(exe):
+		// the release binaries set the version with ldflags
+		m.Name = InstallMethodGo
+	}
+
+	return m, nil
+}
+
+// isInGoBin reports whether the executable is in the folder go install writes to
+func isInGoBin(exe string) bool {
+	dir := filepath.Dir(exe)
+
+	var binDirs []string
+	if gobin := os.Getenv("GOBIN"); gobin != "" {
+		binDirs = append(binDirs, gobin)
+	}
+	if gopath := os.Getenv("GOPATH"); gopath != "" {
+		for _, p := range filepath.SplitList(gopath) {
+			binDirs = append(binDirs, filepath.Join(p, "bin"))
+		}
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		binDirs = append(binDirs, filepath.Join(home, "go", "bin"))
+	}
+
+	for _, binDir := range binDirs {
+		if resolved, err := filepath.EvalSymlinks(binDir); err == nil {
+			binDir = resolved
+		}
+		if binDir == dir {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasGoToolchain reports whether go is available to go install refiber-cli
+func HasGoToolchain() bool {
+	_, err := exec.LookPath("go")
+	return err == nil
+}
+
+// ReleaseAssetName returns the name of the release binary of the platform, e.g. refiber-cli_linux_amd64
+func ReleaseAssetName() string {
+	name := fmt.Sprintf("refiber-cli_%s_%s", runtime.GOOS, runtime.GOARCH)
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+
+	return name
+}
+
+// DownloadReleaseBinary downloads the release binary of the platform next to the executable,
+// and verifies it with the checksums.txt of the release. The path of the download is returned
+func DownloadReleaseBinary(version, exe string) (string, error) {
+	asset := ReleaseAssetName()
+	baseURL := fmt.Sprintf("%s/download/%s/", CLIReleasesURL, version)
+
+	checksums, err := downloadChecksums(baseURL + "checksums.txt")
+	if err != nil {
+		return "", err
+	}
+	expected, ok := checksums[asset]
+	if !ok {
+		return "", fmt.Errorf("%s has no binary for %s/%s", version, runtime.GOOS, runtime.GOARCH)
+	}
+
+	// the same folder as the executable, so the rename replacing it is atomic
+	tempFile, err := os.CreateTemp(filepath.Dir(exe), ".refiber-cli-"+version+"-*")
+	if err != nil {
+		return "", err
+	}
+	tempFile.Close()
+
+	if err := DownloadFile(baseURL+asset, tempFile.Name()); err != nil {
+		os.Remove(tempFile.Name())
+		return "", err
+	}
+
+	sum, err := FileSHA256(tempFile.Name())
+	if err != nil {
+		os.Remove(tempFile.Name())
+		return "", err
+	}
+	if sum != expected {
+		os.Remove(tempFile.Name())
+		return "", fmt.Errorf("checksum mismatch for %s: expected %s, got %s", asset, expected, sum)
+	}
+
+	if err := os.Chmod(tempFile.Name(), 0755); err != nil {
+		os.Remove(tempFile.Name())
+		return "", err
+	}
+
+	return tempFile.Name(), nil
+}
+
+// downloadChecksums parses a checksums.txt in the sha256sum format
+func downloadChecksums(url string) (map[string]string, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: %s", url, resp.Status)
+	}
+
+	checksums := map[string]string{}
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 {
+			checksums[strings.TrimPrefix(fields[1], "*")] = fields[0]
+		}
+	}
+
+	return checksums, scanner.Err()
+}
+
+// ReplaceExecutable atomically replaces the executable with the new binary
+func ReplaceExecutable(exe, newPath string) error {
+	if runtime.GOOS == "windows" {
+		// a running executable can not be overwritten on windows, but it can be renamed
+		oldPath := exe + ".old"
+		os.Remove(oldPath)
+		if err := os.Rename(exe, oldPath); err != nil {
+			return err
+		}
+		if err := os.Rename(newPath, exe); err != nil {
+			os.Rename(oldPath, exe)
+			return err
+		}
+		return nil
+	}
+
+	return os.Rename(newPath, exe)
+}
+
+// GetBackupDirPath returns the folder of the executable backed up before an update
+func GetBackupDirPath() (string, error) {
+	configDir, err := GetConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "backup"), nil
+}
+
+func backupPaths() (binPath, versionPath string, err error) {
+	dir, err := GetBackupDirPath()
+	if err != nil {
+		return "", "", err
+	}
+
+	name := "refiber-cli"
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+
+	return filepath.Join(dir, name), filepath.Join(dir, "version"), nil
+}
+
+// BackupExecutable copies the executable to the backup folder, replacing the previous backup
+func BackupExecutable(exe, version string) error {
+	binPath, versionPath, err := backupPaths()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(binPath), 0755); err != nil {
+		return err
+	}
+
+	if err := copyExecutable(exe, binPath); err != nil {
+		return err
+	}
+
+	return os.WriteFile(versionPath, []byte(version+"\n"), 0644)
+}
+
+// GetBackupVersion returns the version of the backed up executable, an error when there is no backup
+func GetBackupVersion() (string, error) {
+	binPath, versionPath, err := backupPaths()
+	if err != nil {
+		return "", err
+	}
+
+	if !DoesDirectoryOrFileExist(binPath) {
+		return "", fmt.Errorf("no backup found, a backup is made by update")
+	}
+
+	content, err := os.ReadFile(versionPath)
+	if err != nil {
+		return "unknown version", nil
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
+
+// RestoreBackup replaces the executable with the backup, and keeps the replaced executable
+// of the version as the backup so the rollback can be undone
+func RestoreBackup(exe, version string) error {
+	binPath, versionPath, err := backupPaths()
+	if err != nil {
+		return err
+	}
+
+	if _, err := GetBackupVersion(); err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(exe), ".refiber-cli-rollback-*")
+	if err != nil {
+		return err
+	}
+	tempFile.Close()
+
+	if err := copyExecutable(binPath, tempFile.Name()); err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+
+	// the replaced executable becomes the backup once the rollback succeeded
+	nextBackupPath := binPath + ".next"
+	if err := copyExecutable(exe, nextBackupPath); err != nil {
+		os.Remove(tempFile.Name())
+		return err
+	}
+
+	if err := ReplaceExecutable(exe, tempFile.Name()); err != nil {
+		os.Remove(tempFile.Name())
+		os.Remove(nextBackupPath)
+		return err
+	}
+
+	if err := os.Rename(nextBackupPath, binPath); err != nil {
+		return err
+	}
+
+	return os.WriteFile(versionPath, []byte(version+"\n"), 0644)
+}
+
+func copyExecutable(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	// the mode of OpenFile only applies to a new file
+	return os.Chmod(dest, 0755)
+}
@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/refiber/refiber-cli/cmd/templates"
+)
+
+const (
+	TemplateSourceProject = "project"
+	TemplateSourceConfig  = "config"
+	TemplateSourceGlobal  = "global"
+	TemplateSourceVendor  = "vendor"
+	TemplateSourceModule  = "module"
+	TemplateSourceCLI     = "cli"
+)
+
+// TemplateSource is a place where generator templates are looked up
+type TemplateSource struct {
+	Name string
+	FS   fs.FS
+	Dir  string // empty for the templates bundled with the CLI
+}
+
+// GetProjectStubsDirPath returns the folder of the templates published in the project
+func GetProjectStubsDirPath(currentWorkingDir *string) string {
+	return filepath.Join(*currentWorkingDir, "stubs")
+}
+
+// GetGlobalStubsDirPath returns the folder of the templates shared by every project of the user,
+// REFIBER_STUBS_PATH overrides the default folder in the user config folder
+func GetGlobalStubsDirPath() (string, error) {
+	if p := os.Getenv("REFIBER_STUBS_PATH"); p != "" {
+		return p, nil
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "refiber", "stubs"), nil
+}
+
+// GetTemplateSources returns the template sources in lookup order: project stubs, the folders
+// of the templates.sources setting, global stubs, the framework in vendor, the framework in the module cache and the CLI
+func GetTemplateSources(currentWorkingDir *string) []*TemplateSource {
+	var sources []*TemplateSource
+
+	add := func(name, dir string) {
+		if dir != "" && DoesDirectoryOrFileExist(dir) {
+			sources = append(sources, &TemplateSource{Name: name, FS: os.DirFS(dir), Dir: dir})
+		}
+	}
+
+	add(TemplateSourceProject, GetProjectStubsDirPath(currentWorkingDir))
+
+	for _, dir := range GetConfig().GetPaths(ConfigTemplateSources) {
+		add(TemplateSourceConfig, dir)
+	}
+
+	if globalDir, err := GetGlobalStubsDirPath(); err == nil {
+		add(TemplateSourceGlobal, globalDir)
+	}
+
+	if vendorPath, modulePath, err := getRefiberTemplateDirCandidates(currentWorkingDir); err == nil {
+		add(TemplateSourceVendor, vendorPath)
+		add(TemplateSourceModule, modulePath)
+	}
+
+	sources = append(sources, &TemplateSource{Name: TemplateSourceCLI, FS: templates.FS})
+
+	return sources
+}
+
+// GetUpstreamTemplateSources returns the sources that are not customized by the user,
+// the framework templates first and then the CLI templates
+func GetUpstreamTemplateSources(currentWorkingDir *string) []*TemplateSource {
+	var sources []*TemplateSource
+	for _, source := range GetTemplateSources(currentWorkingDir) {
+		if source.Name != TemplateSourceProject && source.Name != TemplateSourceConfig && source.Name != TemplateSourceGlobal {
+			sources = append(sources, source)
+		}
+	}
+
+	return sources
+}
+
+// ReadTemplate reads a generator template, e.g. controller/controller.go.tmpl,
+// from the first template source containing it
+func ReadTemplate(currentWorkingDir *string, name string) ([]byte, error) {
+	content, _, err := ResolveTemplate(GetTemplateSources(currentWorkingDir), name)
+	return content, err
+}
+
+// ResolveTemplate reads a template from the first source containing it
+func ResolveTemplate(sources []*TemplateSource, name string) ([]byte, *TemplateSource, error) {
+	for _, source := range sources {
+		content, err := fs.ReadFile(source.FS, filepath.ToSlash(name))
+		if err == nil {
+			return content, source, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("template %s not found. Make sure you are using the newest version of Refiber", name)
+}
+
+// ListTemplates returns the template names of a source, e.g. controller/controller.go.tmpl
+func ListTemplates(source *TemplateSource) ([]string, error) {
+	var names []string
+
+	err := fs.WalkDir(source.FS, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".tmpl" {
+			return nil
+		}
+
+		names = append(names, path)
+		return nil
+	})
+
+	return names, err
+}
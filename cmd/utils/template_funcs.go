@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// TemplateFuncs returns the functions available in every generator template,
+// values are the extra data passed with --set key=value
+func TemplateFuncs(moduleName string, values map[string]string) template.FuncMap {
+	return template.FuncMap{
+		// inflection
+		"plural":   Pluralize,
+		"singular": Singularize,
+
+		// case conversions
+		"snake":   ToSnakeCase,
+		"kebab":   ToKebabCase,
+		"camel":   ToCamelCase,
+		"pascal":  ToPascalCase,
+		"lower":   strings.ToLower,
+		"upper":   strings.ToUpper,
+		"lcfirst": GetLowercaseFirstChar,
+
+		// routes
+		"routePath":     RoutePath,
+		"resourceRoute": ResourceRoutePath,
+		"joinRoute":     joinRoutePath,
+
+		// project
+		"module": func() string { return moduleName },
+		"year":   func() int { return time.Now().Year() },
+
+		// --set key=value
+		"value": func(key string, fallback ...string) string {
+			if v, ok := values[key]; ok {
+				return v
+			}
+			if len(fallback) > 0 {
+				return fallback[0]
+			}
+			return ""
+		},
+		"values": func() map[string]string { return values },
+	}
+}
+
+// ParseTemplateValues parses the key=value pairs of the --set flag
+func ParseTemplateValues(pairs []string) (map[string]string, error) {
+	values := map[string]string{}
+
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --set %q, use the key=value format", pair)
+		}
+
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+// RoutePath returns the route path of a resource, e.g. ProductCategory -> /product-categories
+func RoutePath(name string) string {
+	return "/" + ToKebabCase(Pluralize(name))
+}
+
+// ResourceRoutePath returns the route path of a resource action, e.g. Product, Edit -> /products/:id/edit
+func ResourceRoutePath(name, action string) (string, error) {
+	for _, a := range ResourceActions {
+		if strings.EqualFold(a.Name, action) {
+			return joinRoutePath(RoutePath(name), a.Path), nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown resource action %s", action)
+}
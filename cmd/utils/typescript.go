@@ -0,0 +1,470 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// PropsMarker marks a struct that should always be emitted, even when it is not passed to a page
+const PropsMarker = "refiber:props"
+
+type TypeScriptGenerator struct {
+	pages   map[string][]string // page name -> props types, more than one when rendered with different props
+	decls   map[*types.TypeName]string
+	names   map[string]*types.TypeName
+	order   []*types.TypeName
+	pending []*types.TypeName
+}
+
+// GenerateTypeScript loads the packages of the project and emits TypeScript declarations for
+// every struct passed as props to a page and every struct marked with the refiber:props comment
+func GenerateTypeScript(projectPath string) ([]byte, error) {
+	// the dependencies are type checked from source, the export data of the
+	// installed toolchain is not always readable by golang.org/x/tools
+	cfg := &packages.Config{
+		Mode:  packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo | packages.NeedFiles,
+		Dir:   projectPath,
+		Tests: false,
+	}
+
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return nil, err
+	}
+
+	var loadErrors []string
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		for _, e := range pkg.Errors {
+			loadErrors = append(loadErrors, e.Error())
+		}
+	})
+	if len(loadErrors) > 0 {
+		return nil, fmt.Errorf("unable to load the project packages:\n%s", strings.Join(loadErrors, "\n"))
+	}
+
+	g := &TypeScriptGenerator{
+		pages: map[string][]string{},
+		decls: map[*types.TypeName]string{},
+		names: map[string]*types.TypeName{},
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Syntax {
+			g.collectMarkedStructs(pkg, file)
+			g.collectPageProps(pkg, file)
+		}
+	}
+
+	for len(g.pending) > 0 {
+		obj := g.pending[0]
+		g.pending = g.pending[1:]
+		g.decls[obj] = g.declaration(obj)
+	}
+
+	return g.render(), nil
+}
+
+func (g *TypeScriptGenerator) collectMarkedStructs(pkg *packages.Package, file *ast.File) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+
+			doc := ts.Doc
+			if doc == nil && len(gen.Specs) == 1 {
+				doc = gen.Doc
+			}
+			if doc == nil || !strings.Contains(doc.Text()+commentDirectives(doc), PropsMarker) {
+				continue
+			}
+
+			if obj, ok := pkg.TypesInfo.Defs[ts.Name].(*types.TypeName); ok {
+				g.reference(obj)
+			}
+		}
+	}
+}
+
+// commentDirectives returns the //directive comments that ast.CommentGroup.Text leaves out
+func commentDirectives(doc *ast.CommentGroup) string {
+	var s strings.Builder
+	for _, c := range doc.List {
+		if strings.HasPrefix(c.Text, "//"+PropsMarker) {
+			s.WriteString(c.Text)
+		}
+	}
+	return s.String()
+}
+
+// collectPageProps finds render calls with a page component name, e.g.
+// Render(c, "Products/Index", props), and maps the props that follow the name
+func (g *TypeScriptGenerator) collectPageProps(pkg *packages.Package, file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !strings.Contains(sel.Sel.Name, "Render") {
+			return true
+		}
+
+		for i, arg := range call.Args {
+			lit, ok := arg.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				continue
+			}
+
+			page, err := strconv.Unquote(lit.Value)
+			if err != nil || page == "" {
+				break
+			}
+
+			props := "Record<string, never>"
+			if i+1 < len(call.Args) {
+				props = g.propsType(pkg, call.Args[i+1])
+			}
+
+			// the page may be rendered with different props, accept all of them
+			if !contains(g.pages[page], props) {
+				g.pages[page] = append(g.pages[page], props)
+			}
+			break
+		}
+
+		return true
+	})
+}
+
+func (g *TypeScriptGenerator) propsType(pkg *packages.Package, expr ast.Expr) string {
+	// inertia.Props{"products": products}, fiber.Map{...}
+	if lit, ok := unparen(expr).(*ast.CompositeLit); ok {
+		if _, isMap := pkg.TypesInfo.TypeOf(lit).Underlying().(*types.Map); isMap {
+			var fields []string
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, ok := kv.Key.(*ast.BasicLit)
+				if !ok || key.Kind != token.STRING {
+					continue
+				}
+				name, _ := strconv.Unquote(key.Value)
+				fields = append(fields, fmt.Sprintf("  %s: %s", tsPropertyName(name), g.tsType(pkg.TypesInfo.TypeOf(kv.Value))))
+			}
+			if len(fields) == 0 {
+				return "Record<string, never>"
+			}
+			return "{\n" + strings.Join(fields, "\n") + "\n}"
+		}
+	}
+
+	t := pkg.TypesInfo.TypeOf(expr)
+	if t == nil {
+		return "unknown"
+	}
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	return g.tsType(t)
+}
+
+func unparen(expr ast.Expr) ast.Expr {
+	for {
+		switch e := expr.(type) {
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.UnaryExpr:
+			if e.Op != token.AND {
+				return expr
+			}
+			expr = e.X
+		default:
+			return expr
+		}
+	}
+}
+
+// reference registers a named type to be declared and returns its TypeScript name
+func (g *TypeScriptGenerator) reference(obj *types.TypeName) string {
+	for name, o := range g.names {
+		if o == obj {
+			return name
+		}
+	}
+
+	name := obj.Name()
+	if other, taken := g.names[name]; taken && other != obj {
+		name = ToPascalCase(obj.Pkg().Name()) + name
+	}
+
+	g.names[name] = obj
+	g.order = append(g.order, obj)
+	g.pending = append(g.pending, obj)
+
+	return name
+}
+
+func (g *TypeScriptGenerator) nameOf(obj *types.TypeName) string {
+	for name, o := range g.names {
+		if o == obj {
+			return name
+		}
+	}
+	return obj.Name()
+}
+
+func (g *TypeScriptGenerator) tsType(t types.Type) string {
+	switch t := types.Unalias(t).(type) {
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsBoolean != 0:
+			return "boolean"
+		case t.Info()&types.IsNumeric != 0 && t.Info()&types.IsComplex == 0:
+			return "number"
+		case t.Info()&types.IsString != 0:
+			return "string"
+		case t.Kind() == types.UntypedNil:
+			return "null"
+		}
+		return "unknown"
+
+	case *types.Pointer:
+		return g.tsType(t.Elem()) + " | null"
+
+	case *types.Slice:
+		if b, ok := t.Elem().(*types.Basic); ok && b.Kind() == types.Byte {
+			// encoding/json encodes []byte as a base64 string
+			return "string"
+		}
+		return arrayOf(g.tsType(t.Elem()))
+
+	case *types.Array:
+		return arrayOf(g.tsType(t.Elem()))
+
+	case *types.Map:
+		key := "string"
+		if b, ok := t.Key().Underlying().(*types.Basic); ok && b.Info()&types.IsNumeric != 0 {
+			key = "number"
+		}
+		return fmt.Sprintf("Record<%s, %s>", key, g.tsType(t.Elem()))
+
+	case *types.Interface:
+		return "any"
+
+	case *types.TypeParam:
+		return t.Obj().Name()
+
+	case *types.Struct:
+		return g.structBody(t, "")
+
+	case *types.Named:
+		obj := t.Obj()
+
+		if obj.Pkg() != nil {
+			switch obj.Pkg().Path() + "." + obj.Name() {
+			case "time.Time":
+				return "string"
+			case "time.Duration":
+				return "number"
+			case "encoding/json.RawMessage":
+				return "unknown"
+			}
+		}
+
+		if implementsJSONMarshaler(t) {
+			return "unknown"
+		}
+
+		if obj.Pkg() == nil {
+			// error
+			return "unknown"
+		}
+
+		origin := t.Origin()
+		name := g.reference(origin.Obj())
+
+		if args := t.TypeArgs(); args != nil && args.Len() > 0 {
+			var params []string
+			for i := 0; i < args.Len(); i++ {
+				params = append(params, g.tsType(args.At(i)))
+			}
+			return name + "<" + strings.Join(params, ", ") + ">"
+		}
+
+		return name
+	}
+
+	return "unknown"
+}
+
+func arrayOf(t string) string {
+	if strings.ContainsAny(t, " |") {
+		return "(" + t + ")[]"
+	}
+	return t + "[]"
+}
+
+func implementsJSONMarshaler(t types.Type) bool {
+	methods := types.NewMethodSet(types.NewPointer(t))
+	for i := 0; i < methods.Len(); i++ {
+		if methods.At(i).Obj().Name() == "MarshalJSON" {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *TypeScriptGenerator) declaration(obj *types.TypeName) string {
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return ""
+	}
+
+	name := g.nameOf(obj)
+
+	if params := named.TypeParams(); params != nil && params.Len() > 0 {
+		var p []string
+		for i := 0; i < params.Len(); i++ {
+			p = append(p, params.At(i).Obj().Name())
+		}
+		name += "<" + strings.Join(p, ", ") + ">"
+	}
+
+	if st, ok := named.Underlying().(*types.Struct); ok {
+		return fmt.Sprintf("export interface %s %s", name, g.structBody(st, ""))
+	}
+
+	return fmt.Sprintf("export type %s = %s", name, g.tsType(named.Underlying()))
+}
+
+func (g *TypeScriptGenerator) structBody(st *types.Struct, indent string) string {
+	var b strings.Builder
+	b.WriteString("{\n")
+	g.writeFields(&b, st, indent+"  ")
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+// writeFields follows the rules of encoding/json: json tags, omitempty and embedded structs
+func (g *TypeScriptGenerator) writeFields(b *strings.Builder, st *types.Struct, indent string) {
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i)).Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+		options := parts[1:]
+
+		if field.Embedded() && name == "" {
+			t := field.Type()
+			if ptr, ok := t.(*types.Pointer); ok {
+				t = ptr.Elem()
+			}
+			if embedded, ok := t.Underlying().(*types.Struct); ok {
+				g.writeFields(b, embedded, indent)
+				continue
+			}
+		}
+
+		if !field.Exported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name()
+		}
+
+		optional := ""
+		tsType := g.tsType(field.Type())
+		for _, option := range options {
+			switch option {
+			case "omitempty":
+				optional = "?"
+			case "string":
+				tsType = "string"
+			}
+		}
+
+		b.WriteString(fmt.Sprintf("%s%s%s: %s\n", indent, tsPropertyName(name), optional, tsType))
+	}
+}
+
+func tsPropertyName(name string) string {
+	for i, r := range name {
+		if !(r == '_' || r == '$' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || (i > 0 && '0' <= r && r <= '9')) {
+			return strconv.Quote(name)
+		}
+	}
+	return name
+}
+
+func (g *TypeScriptGenerator) render() []byte {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by refiber-cli types:generate. DO NOT EDIT.\n")
+
+	objs := append([]*types.TypeName{}, g.order...)
+	sort.SliceStable(objs, func(i, j int) bool {
+		return g.nameOf(objs[i]) < g.nameOf(objs[j])
+	})
+
+	for _, obj := range objs {
+		if decl := g.decls[obj]; decl != "" {
+			b.WriteString("\n" + decl + "\n")
+		}
+	}
+
+	var pages []string
+	for page := range g.pages {
+		pages = append(pages, page)
+	}
+	sort.Strings(pages)
+
+	for _, page := range pages {
+		members := g.pages[page]
+		name := PagePropsTypeName(page)
+		if len(members) == 1 && strings.HasPrefix(members[0], "{") {
+			b.WriteString(fmt.Sprintf("\nexport interface %s %s\n", name, members[0]))
+		} else {
+			b.WriteString(fmt.Sprintf("\nexport type %s = %s\n", name, strings.Join(members, " | ")))
+		}
+	}
+
+	if len(pages) > 0 {
+		b.WriteString("\nexport interface PageProps {\n")
+		for _, page := range pages {
+			b.WriteString(fmt.Sprintf("  %s: %s\n", strconv.Quote(page), PagePropsTypeName(page)))
+		}
+		b.WriteString("}\n")
+	}
+
+	return b.Bytes()
+}
+
+// PagePropsTypeName returns the props interface name of a page, e.g. Products/Index -> ProductsIndexProps
+func PagePropsTypeName(page string) string {
+	var name string
+	for _, part := range strings.Split(page, "/") {
+		name += ToPascalCase(part)
+	}
+	return name + "Props"
+}
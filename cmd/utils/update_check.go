@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// UpdateCheckInterval is the minimum time between two update checks
+const UpdateCheckInterval = 24 * time.Hour
+
+// NoUpdateCheckEnv disables the update check when set, e.g. REFIBER_NO_UPDATE_CHECK=1
+const NoUpdateCheckEnv = "REFIBER_NO_UPDATE_CHECK"
+
+// UpdateCheck is the result of the last update check, cached in the config folder
+type UpdateCheck struct {
+	CheckedAt time.Time `json:"checked_at"`
+	CLI       string    `json:"cli,omitempty"`       // latest release of refiber-cli
+	Framework string    `json:"framework,omitempty"` // latest release of the framework
+}
+
+func updateCheckPath() (string, error) {
+	dir, err := GetConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "update-check.json"), nil
+}
+
+// IsCI reports whether refiber-cli runs in a continuous integration environment
+func IsCI() bool {
+	for _, key := range []string{"CI", "CONTINUOUS_INTEGRATION", "BUILD_NUMBER", "RUN_ID", "GITHUB_ACTIONS", "GITLAB_CI", "BUILDKITE", "TF_BUILD"} {
+		if isTruthy(os.Getenv(key)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsUpdateCheckDisabled reports whether the update check is disabled by the update_check.enabled setting,
+// by NoUpdateCheckEnv or by a CI environment
+func IsUpdateCheckDisabled() bool {
+	return !GetConfig().GetBool(ConfigUpdateCheck) || isTruthy(os.Getenv(NoUpdateCheckEnv)) || IsCI()
+}
+
+func isTruthy(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "0", "false", "no", "off":
+		return false
+	}
+
+	return true
+}
+
+// ReadUpdateCheck returns the cached update check, an empty one when there was no check yet
+func ReadUpdateCheck() (*UpdateCheck, error) {
+	path, err := updateCheckPath()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &UpdateCheck{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	check := &UpdateCheck{}
+	if err := json.Unmarshal(content, check); err != nil {
+		// a corrupted cache is checked again
+		return &UpdateCheck{}, nil
+	}
+
+	return check, nil
+}
+
+// IsDue reports whether the last check is older than UpdateCheckInterval
+func (c *UpdateCheck) IsDue() bool {
+	return time.Since(c.CheckedAt) >= UpdateCheckInterval
+}
+
+// Save writes the update check to the config folder
+func (c *UpdateCheck) Save() error {
+	path, err := updateCheckPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write a temporary file first, concurrent commands may check at the same time
+	tmp := fmt.Sprintf("%s.%d", path, os.Getpid())
+	if err := os.WriteFile(tmp, content, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// RefreshUpdateCheck asks the module proxy for the latest releases of refiber-cli and the framework.
+// The check time is saved first, a failing check is not retried before UpdateCheckInterval
+func RefreshUpdateCheck(previous *UpdateCheck) (*UpdateCheck, error) {
+	check := *previous
+	check.CheckedAt = time.Now()
+	if err := check.Save(); err != nil {
+		return nil, err
+	}
+
+	cli, err := LatestCLIVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	framework, err := LatestFrameworkVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	check.CLI = cli
+	check.Framework = framework
+	return &check, check.Save()
+}
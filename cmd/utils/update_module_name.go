@@ -13,8 +13,11 @@ import (
 
 // TODO: add command for rename module name?
 
+// ScaffoldModuleName is the module name of the project scaffold, replaced by the module name of the project
+const ScaffoldModuleName = "bykevin.work/refiber"
+
 func UpdateModuleNameAndImports(projectPath, moduleName *string, warnings *[]*string) error {
-	defaultModuleName := []byte("bykevin.work/refiber")
+	defaultModuleName := []byte(ScaffoldModuleName)
 	newModuleName := []byte(*moduleName)
 
 	var folderPathError error
@@ -3,11 +3,9 @@ package utils
 import (
 	"bytes"
 	"fmt"
-	"go/build"
 	"io"
 	"net/http"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -15,6 +13,7 @@ import (
 	"unicode"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"golang.org/x/mod/modfile"
 )
 
 func MatchAllStringByRegex(regex, str string) ([]*string, error) {
@@ -53,6 +52,10 @@ func DownloadFile(url string, filepath string) error {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s: %s", url, resp.Status)
+	}
+
 	// Write the body to file
 	_, err = io.Copy(out, resp.Body)
 	if err != nil {
@@ -105,18 +108,6 @@ func CopyFile(sourcePath, destinationPath string) error {
 	return nil
 }
 
-// ExecuteCmd provides a shorthand way to run a shell command
-func ExecuteCmd(name string, args []string, dir string) error {
-	command := exec.Command(name, args...)
-	command.Dir = dir
-	var out bytes.Buffer
-	command.Stdout = &out
-	if err := command.Run(); err != nil {
-		return err
-	}
-	return nil
-}
-
 func DeferTeaPanicHandler(p *tea.Program) {
 	if r := recover(); r != nil {
 		fmt.Println("The program encountered an unexpected issue and had to exit. The error was:", r)
@@ -136,38 +127,44 @@ func DoesDirectoryOrFileExist(path string) bool {
 }
 
 func GetRefiberTemplateDirPath(currentWorkingDir *string) (*string, error) {
-	// check is current dir is a refiber project by checking go.mod file
-	goModFilePath := filepath.Join(*currentWorkingDir, "go.mod")
-	goModFileContent, err := os.ReadFile(goModFilePath)
+	vendorPath, modulePath, err := getRefiberTemplateDirCandidates(currentWorkingDir)
 	if err != nil {
-		return nil, fmt.Errorf("the current folder path is not inside the Refiber project")
+		return nil, err
+	}
+
+	if DoesDirectoryOrFileExist(vendorPath) {
+		return &vendorPath, nil
+	}
+
+	if DoesDirectoryOrFileExist(modulePath) {
+		return &modulePath, nil
 	}
 
-	var refiberVersion string
+	return nil, fmt.Errorf("template folder not found. Make sure you are using the newest version of Refiber")
+}
 
-	pattern := `github\.com/refiber/framework\s(v.+)`
-	match := regexp.MustCompile(pattern).FindSubmatch(goModFileContent)
-	if len(match) > 1 {
-		refiberVersion = string(match[1])
-	} else {
-		return nil, fmt.Errorf("the current folder path is not inside the Refiber project")
+// getRefiberTemplateDirCandidates returns the template folders of the framework in vendor and in the module folder
+func getRefiberTemplateDirCandidates(currentWorkingDir *string) (vendorPath, modulePath string, err error) {
+	// check is current dir is a refiber project by checking go.mod file
+	if !IsRefiberProject(*currentWorkingDir) {
+		return "", "", fmt.Errorf("the current folder path is not inside the Refiber project")
 	}
 
-	templatePathInVendor := filepath.Join(*currentWorkingDir, "vendor", "github.com", "refiber", "framework", "templates")
-	if DoesDirectoryOrFileExist(templatePathInVendor) {
-		return &templatePathInVendor, nil
+	vendorPath = filepath.Join(*currentWorkingDir, "vendor", "github.com", "refiber", "framework", "templates")
+	if DoesDirectoryOrFileExist(vendorPath) {
+		return vendorPath, "", nil
 	}
 
-	gopath := os.Getenv("GOPATH")
-	if gopath == "" {
-		gopath = build.Default.GOPATH
+	m, err := GetFrameworkModule(*currentWorkingDir)
+	if err != nil {
+		return "", "", err
 	}
-	templatePathInGoPkg := filepath.Join(gopath, "pkg", "mod", "github.com", "refiber", fmt.Sprintf("framework@%s", refiberVersion), "templates")
-	if DoesDirectoryOrFileExist(templatePathInGoPkg) {
-		return &templatePathInGoPkg, nil
+
+	if m.Dir != "" {
+		modulePath = filepath.Join(m.Dir, "templates")
 	}
 
-	return nil, fmt.Errorf("template folder not found. Make sure you are using the newest version of Refiber")
+	return vendorPath, modulePath, nil
 }
 
 func ExecuteTemplate(t *template.Template, data interface{}) ([]byte, error) {
@@ -230,3 +227,39 @@ func GetLastPathName(path string) string {
 	parts := strings.Split(path, "/")
 	return parts[len(parts)-1]
 }
+
+// GetModuleName returns the module path declared in the go.mod file of the project
+func GetModuleName(projectPath string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	if err != nil {
+		return "", fmt.Errorf("the current folder path is not inside the Refiber project")
+	}
+
+	modulePath := modfile.ModulePath(content)
+	if modulePath == "" {
+		return "", fmt.Errorf("unable to find the module name in go.mod")
+	}
+
+	return modulePath, nil
+}
+
+// HasModuleDependency reports whether the go.mod file of the project requires the module
+func HasModuleDependency(projectPath, modulePath string) bool {
+	content, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	if err != nil {
+		return false
+	}
+
+	f, err := modfile.ParseLax("go.mod", content, nil)
+	if err != nil {
+		return false
+	}
+
+	for _, r := range f.Require {
+		if r.Mod.Path == modulePath {
+			return true
+		}
+	}
+
+	return false
+}
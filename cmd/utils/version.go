@@ -0,0 +1,211 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// CLIModulePath is the module of refiber-cli, installed with go install
+const CLIModulePath = "github.com/refiber/refiber-cli"
+
+// Version, Commit and Date are set by the release builds with
+// -ldflags "-X github.com/refiber/refiber-cli/cmd/utils.Version=v1.2.3 ..."
+var (
+	Version string
+	Commit  string
+	Date    string
+)
+
+// BuildInfo describes the running refiber-cli
+type BuildInfo struct {
+	Version   string `json:"version"` // empty for a development build
+	Commit    string `json:"commit,omitempty"`
+	Date      string `json:"date,omitempty"`
+	Modified  bool   `json:"modified,omitempty"` // built from a repository with uncommitted changes
+	GoVersion string `json:"go_version"`
+	Platform  string `json:"platform"`
+}
+
+// GetBuildInfo returns the version of the ldflags, or the build info embedded by go install
+func GetBuildInfo() *BuildInfo {
+	info := &BuildInfo{
+		Version:   Version,
+		Commit:    Commit,
+		Date:      Date,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	// (devel) or a pseudo-version for a binary built from a clone
+	if v := buildInfo.Main.Version; info.Version == "" && semver.IsValid(v) && semver.Build(v) == "" && !module.IsPseudoVersion(v) {
+		info.Version = buildInfo.Main.Version
+	}
+
+	for _, setting := range buildInfo.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if info.Commit == "" && len(setting.Value) >= 7 {
+				info.Commit = setting.Value[:7]
+			}
+		case "vcs.time":
+			if info.Date == "" {
+				info.Date = setting.Value
+			}
+		case "vcs.modified":
+			info.Modified = setting.Value == "true"
+		}
+	}
+
+	return info
+}
+
+// String formats the build info, e.g. v1.2.3 (abc1234, 2024-05-01T10:00:00Z) go1.22.0 linux/amd64
+func (info *BuildInfo) String() string {
+	version := info.Version
+	if version == "" {
+		version = "dev"
+	}
+
+	var details []string
+	if info.Commit != "" {
+		commit := info.Commit
+		if info.Modified {
+			commit += "-dirty"
+		}
+		details = append(details, commit)
+	}
+	if info.Date != "" {
+		details = append(details, info.Date)
+	}
+	if len(details) > 0 {
+		version += " (" + strings.Join(details, ", ") + ")"
+	}
+
+	return version + " " + info.GoVersion + " " + info.Platform
+}
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// getJSON decodes the JSON response of a GET request
+func getJSON(url string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// getContent returns the body of a GET request
+func getContent(url string) ([]byte, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// moduleProxyURL returns the first module proxy of GOPROXY, proxy.golang.org by default
+func moduleProxyURL() string {
+	for _, proxy := range strings.FieldsFunc(os.Getenv("GOPROXY"), func(r rune) bool { return r == ',' || r == '|' }) {
+		if strings.HasPrefix(proxy, "http://") || strings.HasPrefix(proxy, "https://") {
+			return strings.TrimSuffix(proxy, "/")
+		}
+	}
+
+	return "https://proxy.golang.org"
+}
+
+// LatestCLIVersion asks the module proxy for the latest release of refiber-cli
+func LatestCLIVersion() (string, error) {
+	var latest struct {
+		Version string
+	}
+
+	if err := getJSON(moduleProxyURL()+"/"+CLIModulePath+"/@latest", &latest); err != nil {
+		return "", fmt.Errorf("unable to check the latest version: %w", err)
+	}
+
+	return latest.Version, nil
+}
+
+// LatestFrameworkVersion asks the module proxy for the latest release of the framework
+func LatestFrameworkVersion() (string, error) {
+	var latest struct {
+		Version string
+	}
+
+	if err := getJSON(moduleProxyURL()+"/"+FrameworkModulePath+"/@latest", &latest); err != nil {
+		return "", fmt.Errorf("unable to check the latest framework version: %w", err)
+	}
+
+	return latest.Version, nil
+}
+
+// Release is a GitHub release of refiber-cli or of the scaffold
+type Release struct {
+	Version string `json:"tag_name"`
+	Name    string `json:"name"`
+	Notes   string `json:"body"`
+	URL     string `json:"html_url"`
+}
+
+// CLIReleasesURL is the page listing the releases of refiber-cli
+const CLIReleasesURL = "https://github.com/refiber/refiber-cli/releases"
+
+// GetCLIChangelog returns the releases newer than from, up to and including to, the newest first
+func GetCLIChangelog(from, to string) ([]*Release, error) {
+	var releases []*Release
+	if err := getJSON("https://api.github.com/repos/refiber/refiber-cli/releases?per_page=100", &releases); err != nil {
+		return nil, err
+	}
+
+	var changelog []*Release
+	for _, r := range releases {
+		if !semver.IsValid(r.Version) {
+			continue
+		}
+		if (from == "" || semver.Compare(r.Version, from) > 0) && semver.Compare(r.Version, to) <= 0 {
+			changelog = append(changelog, r)
+		}
+	}
+
+	sort.Slice(changelog, func(i, j int) bool {
+		return semver.Compare(changelog[i].Version, changelog[j].Version) > 0
+	})
+
+	return changelog, nil
+}
@@ -0,0 +1,225 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ignoredDirs are never watched
+var ignoredDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+	"tmp":          true,
+}
+
+// WatchFiles polls the files below root accepted by match and calls onChange
+// after something changed, until the context is canceled
+func WatchFiles(ctx context.Context, root string, interval time.Duration, match func(path string) bool, onChange func()) error {
+	previous, err := snapshotFiles(root, match, nil)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			current, err := snapshotFiles(root, match, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(changedFiles(previous, current)) > 0 {
+				onChange()
+			}
+			previous = current
+		}
+	}
+}
+
+// WatchOptions configures WatchChanges, the globs are relative to the root
+type WatchOptions struct {
+	Include  []string // e.g. **/*.go
+	Exclude  []string // e.g. **/*_test.go
+	Interval time.Duration
+	Debounce time.Duration // quiet time after the last change before onChange is called
+}
+
+// WatchChanges polls the files below root matching the include globs but not the exclude
+// globs, and calls onChange with the changed files once nothing changed during the debounce
+func WatchChanges(ctx context.Context, root string, opts *WatchOptions, onChange func(changed []string)) error {
+	matchAny := func(patterns []string, rel string) bool {
+		for _, pattern := range patterns {
+			if MatchGlob(pattern, rel) {
+				return true
+			}
+		}
+		return false
+	}
+
+	relPath := func(p string) string {
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return p
+		}
+		return filepath.ToSlash(rel)
+	}
+
+	match := func(p string) bool {
+		rel := relPath(p)
+		return matchAny(opts.Include, rel) && !matchAny(opts.Exclude, rel)
+	}
+	skipDir := func(p string) bool {
+		return matchAny(opts.Exclude, relPath(p))
+	}
+
+	interval := opts.Interval
+	if interval == 0 {
+		interval = 300 * time.Millisecond
+	}
+
+	previous, err := snapshotFiles(root, match, skipDir)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	pending := map[string]bool{}
+	var lastChange time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			current, err := snapshotFiles(root, match, skipDir)
+			if err != nil {
+				return err
+			}
+
+			for _, p := range changedFiles(previous, current) {
+				pending[relPath(p)] = true
+				lastChange = now
+			}
+			previous = current
+
+			if len(pending) == 0 || now.Sub(lastChange) < opts.Debounce {
+				continue
+			}
+
+			var changed []string
+			for p := range pending {
+				changed = append(changed, p)
+			}
+			sort.Strings(changed)
+			pending = map[string]bool{}
+
+			onChange(changed)
+		}
+	}
+}
+
+// MatchGlob reports whether a slash separated path matches a glob, ** matches any number
+// of folders and a glob without a slash matches the file name in any folder
+func MatchGlob(pattern, name string) bool {
+	pattern = strings.TrimPrefix(filepath.ToSlash(pattern), "./")
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+
+	return matchGlobParts(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchGlobParts(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchGlobParts(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+func snapshotFiles(root string, match func(path string) bool, skipDir func(path string) bool) (map[string]time.Time, error) {
+	files := map[string]time.Time{}
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		// a file or folder removed during the walk, e.g. by a branch switch or an atomic save
+		if errors.Is(err, fs.ErrNotExist) && path != root {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if path != root && (ignoredDirs[d.Name()] || strings.HasPrefix(d.Name(), ".") || (skipDir != nil && skipDir(path))) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !match(path) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		files[path] = info.ModTime()
+
+		return nil
+	})
+
+	return files, err
+}
+
+// changedFiles returns the files created, modified or removed between two snapshots
+func changedFiles(previous, current map[string]time.Time) []string {
+	var changed []string
+
+	for path, modTime := range current {
+		if other, ok := previous[path]; !ok || !other.Equal(modTime) {
+			changed = append(changed, path)
+		}
+	}
+	for path := range previous {
+		if _, ok := current[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/refiber/refiber-cli/cmd/ui"
+	"github.com/refiber/refiber-cli/cmd/utils"
+)
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the version of refiber-cli",
+	Args:  cobra.NoArgs,
+	Run:   printVersion,
+}
+
+func init() {
+	rootCmd.AddCommand(versionCmd)
+	versionCmd.Flags().Bool("json", false, "Output the build info as JSON")
+	versionCmd.Flags().Bool("check", false, "Check whether a newer version is available")
+}
+
+func printVersion(cmd *cobra.Command, args []string) {
+	info := utils.GetBuildInfo()
+
+	if asJSON, _ := cmd.Flags().GetBool("json"); asJSON {
+		out, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			cobra.CheckErr(ui.TextError.Render(err.Error()))
+		}
+		fmt.Println(string(out))
+		return
+	}
+
+	fmt.Println("refiber-cli " + info.String())
+
+	if check, _ := cmd.Flags().GetBool("check"); !check {
+		return
+	}
+
+	latest, err := utils.LatestCLIVersion()
+	if err != nil {
+		cobra.CheckErr(ui.TextError.Render(err.Error()))
+	}
+
+	if isCurrentVersion(info.Version, latest) {
+		fmt.Println(ui.TextGreen.Render("refiber-cli is up to date"))
+		return
+	}
+
+	fmt.Println(ui.TextWarning.Render(latest+" is available") + ui.TextGray.Render(", run refiber-cli update"))
+}